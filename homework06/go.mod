@@ -2,7 +2,10 @@ module homework6
 
 go 1.19
 
-require github.com/gofiber/fiber/v2 v2.43.0
+require (
+	github.com/gofiber/fiber/v2 v2.43.0
+	github.com/papey08/golang-fintech/validation v0.0.0
+)
 
 require (
 	github.com/andybalholm/brotli v1.0.5 // indirect
@@ -12,12 +15,15 @@ require (
 	github.com/mattn/go-isatty v0.0.17 // indirect
 	github.com/mattn/go-runewidth v0.0.14 // indirect
 	github.com/philhofer/fwd v1.1.2 // indirect
-	github.com/rivo/uniseg v0.2.0 // indirect
+	github.com/pkg/errors v0.9.1 // indirect
+	github.com/rivo/uniseg v0.4.7 // indirect
 	github.com/savsgio/dictpool v0.0.0-20221023140959-7bf2e61cea94 // indirect
 	github.com/savsgio/gotils v0.0.0-20230208104028-c358bd845dee // indirect
 	github.com/tinylib/msgp v1.1.8 // indirect
 	github.com/valyala/bytebufferpool v1.0.0 // indirect
 	github.com/valyala/fasthttp v1.45.0 // indirect
 	github.com/valyala/tcplisten v1.0.0 // indirect
-	golang.org/x/sys v0.6.0 // indirect
+	golang.org/x/sys v0.7.0 // indirect
 )
+
+replace github.com/papey08/golang-fintech/validation => ../validation
@@ -1,9 +1,49 @@
 package adrepo
 
 import (
+	"context"
+	"fmt"
+	"sync"
+
+	"homework6/internal/ads"
 	"homework6/internal/app"
+	"homework6/internal/domainerr"
 )
 
+// repository хранит объявления в памяти, ID объявления совпадает с его
+// индексом в срезе
+type repository struct {
+	mu  sync.Mutex
+	ads []ads.Ad
+}
+
 func New() app.Repository {
-	return nil // TODO: реализовать
+	return &repository{}
+}
+
+func (r *repository) AddAd(_ context.Context, ad ads.Ad) (ads.Ad, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ad.ID = int64(len(r.ads))
+	r.ads = append(r.ads, ad)
+	return ad, nil
+}
+
+func (r *repository) UpdateAd(_ context.Context, id int64, update func(ad *ads.Ad) error) (ads.Ad, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if id < 0 || id >= int64(len(r.ads)) {
+		return ads.Ad{}, fmt.Errorf("ad %d: %w", id, domainerr.ErrNotFound)
+	}
+
+	// update меняет копию, поэтому при ошибке объявление остаётся прежним
+	ad := r.ads[id]
+	if err := update(&ad); err != nil {
+		return ads.Ad{}, err
+	}
+	ad.ID = id
+	r.ads[id] = ad
+	return ad, nil
 }
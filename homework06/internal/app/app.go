@@ -1,13 +1,103 @@
 package app
 
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	validation "github.com/papey08/golang-fintech/validation"
+
+	"homework6/internal/ads"
+	"homework6/internal/domainerr"
+)
+
 type App interface {
-	// TODO: реализовать
+	// CreateAd создаёт неопубликованное объявление пользователя userID
+	CreateAd(ctx context.Context, title, text string, userID int64) (*ads.Ad, error)
+
+	// ChangeAdStatus публикует объявление или снимает его с публикации,
+	// менять статус может только автор
+	ChangeAdStatus(ctx context.Context, adID, userID int64, published bool) (*ads.Ad, error)
+
+	// UpdateAd меняет заголовок и текст объявления, менять их может только
+	// автор
+	UpdateAd(ctx context.Context, adID, userID int64, title, text string) (*ads.Ad, error)
 }
 
 type Repository interface {
-	// TODO: реализовать
+	// AddAd сохраняет объявление со следующим по порядку ID и возвращает его
+	AddAd(ctx context.Context, ad ads.Ad) (ads.Ad, error)
+
+	// UpdateAd атомарно применяет update к объявлению с ID id. Если update
+	// вернула ошибку, объявление не меняется. Для несуществующего объявления
+	// возвращается ошибка, оборачивающая domainerr.ErrNotFound
+	UpdateAd(ctx context.Context, id int64, update func(ad *ads.Ad) error) (ads.Ad, error)
+}
+
+type app struct {
+	repo Repository
 }
 
 func NewApp(repo Repository) App {
-	return nil // TODO: реализовать
+	return &app{repo: repo}
+}
+
+func (a *app) CreateAd(ctx context.Context, title, text string, userID int64) (*ads.Ad, error) {
+	ad := ads.Ad{Title: title, Text: text, AuthorID: userID}
+	if err := validateAd(ad); err != nil {
+		return nil, err
+	}
+
+	ad, err := a.repo.AddAd(ctx, ad)
+	if err != nil {
+		return nil, err
+	}
+	return &ad, nil
+}
+
+func (a *app) ChangeAdStatus(ctx context.Context, adID, userID int64, published bool) (*ads.Ad, error) {
+	ad, err := a.repo.UpdateAd(ctx, adID, func(ad *ads.Ad) error {
+		if ad.AuthorID != userID {
+			return fmt.Errorf("ad %d: %w", adID, domainerr.ErrForbidden)
+		}
+		ad.Published = published
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ad, nil
+}
+
+func (a *app) UpdateAd(ctx context.Context, adID, userID int64, title, text string) (*ads.Ad, error) {
+	ad, err := a.repo.UpdateAd(ctx, adID, func(ad *ads.Ad) error {
+		if ad.AuthorID != userID {
+			return fmt.Errorf("ad %d: %w", adID, domainerr.ErrForbidden)
+		}
+		ad.Title, ad.Text = title, text
+		return validateAd(*ad)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ad, nil
+}
+
+// validateAd проверяет поля объявления по тегам validate и возвращает
+// ошибки полей как *domainerr.ValidationError
+func validateAd(ad ads.Ad) error {
+	err := validation.Validate(ad)
+	var fieldErrs validation.ValidationErrors
+	if !errors.As(err, &fieldErrs) {
+		return err
+	}
+
+	ve := &domainerr.ValidationError{Fields: make([]domainerr.FieldViolation, 0, len(fieldErrs))}
+	for _, fe := range fieldErrs.Localize(validation.DefaultLocale) {
+		ve.Fields = append(ve.Fields, domainerr.FieldViolation{
+			Field:       fe.Field,
+			Description: fe.Message,
+		})
+	}
+	return ve
 }
@@ -0,0 +1,31 @@
+// Package httperr переводит ошибки domainerr в HTTP-статусы. Коды взяты из
+// net/http, поэтому таблица не зависит от веб-фреймворка
+package httperr
+
+import (
+	"errors"
+	"net/http"
+
+	"homework6/internal/domainerr"
+)
+
+// statuses проверяются по порядку, ошибка без совпадений считается
+// внутренней
+var statuses = []struct {
+	err    error
+	status int
+}{
+	{domainerr.ErrValidation, http.StatusBadRequest},
+	{domainerr.ErrForbidden, http.StatusForbidden},
+	{domainerr.ErrNotFound, http.StatusNotFound},
+}
+
+// Status возвращает HTTP-статус для ошибки бизнес-логики
+func Status(err error) int {
+	for _, s := range statuses {
+		if errors.Is(err, s.err) {
+			return s.status
+		}
+	}
+	return http.StatusInternalServerError
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 
 	"homework6/internal/ads"
+	"homework6/internal/domainerr"
 )
 
 type createAdRequest struct {
@@ -45,8 +46,12 @@ func AdSuccessResponse(ad *ads.Ad) *fiber.Map {
 }
 
 func AdErrorResponse(err error) *fiber.Map {
-	return &fiber.Map{
+	resp := fiber.Map{
 		"data":  nil,
 		"error": err.Error(),
 	}
+	if fields := domainerr.Fields(err); fields != nil {
+		resp["fields"] = fields
+	}
+	return &resp
 }
@@ -28,6 +28,7 @@ type adResponse struct {
 
 var ErrBadRequest = fmt.Errorf("bad request")
 var ErrForbidden = fmt.Errorf("forbidden")
+var ErrNotFound = fmt.Errorf("not found")
 
 func getResponse(server httpfiber.Server, req *http.Request, out interface{}) error {
 	resp, err := server.Test(req)
@@ -42,6 +43,9 @@ func getResponse(server httpfiber.Server, req *http.Request, out interface{}) er
 		if resp.StatusCode == http.StatusForbidden {
 			return ErrForbidden
 		}
+		if resp.StatusCode == http.StatusNotFound {
+			return ErrNotFound
+		}
 		return fmt.Errorf("unexpected status code: %s", resp.Status)
 	}
 
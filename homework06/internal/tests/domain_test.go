@@ -37,6 +37,49 @@ func TestUpdateAdOfAnotherUser(t *testing.T) {
 	}
 }
 
+func TestChangeStatusOfMissingAd(t *testing.T) {
+	server := httpfiber.NewHTTPServer(":18080", app.NewApp(adrepo.New()))
+
+	_, err := changeAdStatus(server, 123, 0, true)
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected error, got: %s", err)
+	}
+}
+
+func TestUpdateMissingAd(t *testing.T) {
+	server := httpfiber.NewHTTPServer(":18080", app.NewApp(adrepo.New()))
+
+	_, err := updateAd(server, 123, 0, "title", "text")
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected error, got: %s", err)
+	}
+}
+
+func TestUpdateAd_InvalidKeepsAd(t *testing.T) {
+	server := httpfiber.NewHTTPServer(":18080", app.NewApp(adrepo.New()))
+
+	resp, err := createAd(server, 123, "hello", "world")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err = updateAd(server, 123, resp.Data.ID, "", "text")
+	if !errors.Is(err, ErrBadRequest) {
+		t.Fatalf("expected error, got: %s", err)
+	}
+
+	resp, err = changeAdStatus(server, 123, resp.Data.ID, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resp.Data.Title != "hello" {
+		t.Errorf("invalid title")
+	}
+	if resp.Data.Text != "world" {
+		t.Errorf("invalid text")
+	}
+}
+
 func TestCreateAd_ID(t *testing.T) {
 	server := httpfiber.NewHTTPServer(":18080", app.NewApp(adrepo.New()))
 
@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"homework6/internal/adapters/adrepo"
+	"homework6/internal/app"
+	"homework6/internal/domainerr"
+	"homework6/internal/ports/httperr"
+	"homework6/internal/ports/httpfiber"
+)
+
+func TestErrorTranslation(t *testing.T) {
+	tests := []struct {
+		err        error
+		httpStatus int
+	}{
+		{&domainerr.ValidationError{}, http.StatusBadRequest},
+		{fmt.Errorf("ad 1: %w", domainerr.ErrForbidden), http.StatusForbidden},
+		{fmt.Errorf("ad 1: %w", domainerr.ErrNotFound), http.StatusNotFound},
+		{fmt.Errorf("disk is full"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		if status := httperr.Status(tt.err); status != tt.httpStatus {
+			t.Errorf("%v: expected HTTP status %d, got %d", tt.err, tt.httpStatus, status)
+		}
+	}
+}
+
+func TestCreateAd_ValidationFields(t *testing.T) {
+	server := httpfiber.NewHTTPServer(":18080", app.NewApp(adrepo.New()))
+
+	data, err := json.Marshal(map[string]any{"user_id": 123, "title": "", "text": "world"})
+	if err != nil {
+		t.Fatalf("unable to marshal: %s", err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/ads", bytes.NewReader(data))
+	req.Header.Add("Content-Type", "application/json")
+
+	resp, err := server.Test(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("unexpected status code: %s", resp.Status)
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("unable to read response: %s", err)
+	}
+
+	var response struct {
+		Fields []domainerr.FieldViolation `json:"fields"`
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		t.Fatalf("unable to unmarshal: %s", err)
+	}
+	if len(response.Fields) != 1 || response.Fields[0].Field != "Title" {
+		t.Errorf("invalid fields: %v", response.Fields)
+	}
+}
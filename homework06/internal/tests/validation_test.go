@@ -106,3 +106,68 @@ func TestUpdateAd_TooLongText(t *testing.T) {
 		t.Fatalf("expected error")
 	}
 }
+
+func TestCreateAd_TitleOf100Chars(t *testing.T) {
+	server := httpfiber.NewHTTPServer(":18080", app.NewApp(adrepo.New()))
+
+	title := strings.Repeat("a", 100)
+
+	_, err := createAd(server, 123, title, "world")
+	if !errors.Is(err, ErrBadRequest) {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestCreateAd_TextOf500Chars(t *testing.T) {
+	server := httpfiber.NewHTTPServer(":18080", app.NewApp(adrepo.New()))
+
+	text := strings.Repeat("a", 500)
+
+	_, err := createAd(server, 123, "title", text)
+	if !errors.Is(err, ErrBadRequest) {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestCreateAd_MaxLength(t *testing.T) {
+	server := httpfiber.NewHTTPServer(":18080", app.NewApp(adrepo.New()))
+
+	title := strings.Repeat("a", 99)
+	text := strings.Repeat("a", 499)
+
+	_, err := createAd(server, 123, title, text)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestUpdateAd_TitleOf100Chars(t *testing.T) {
+	server := httpfiber.NewHTTPServer(":18080", app.NewApp(adrepo.New()))
+
+	resp, err := createAd(server, 123, "hello", "world")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	title := strings.Repeat("a", 100)
+
+	_, err = updateAd(server, 123, resp.Data.ID, title, "world")
+	if !errors.Is(err, ErrBadRequest) {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestUpdateAd_TextOf500Chars(t *testing.T) {
+	server := httpfiber.NewHTTPServer(":18080", app.NewApp(adrepo.New()))
+
+	text := strings.Repeat("a", 500)
+
+	resp, err := createAd(server, 123, "hello", "world")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	_, err = updateAd(server, 123, resp.Data.ID, "title", text)
+	if !errors.Is(err, ErrBadRequest) {
+		t.Fatalf("expected error")
+	}
+}
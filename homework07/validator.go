@@ -1,25 +1,55 @@
 package homework
 
 import (
+	"strings"
+
 	"github.com/pkg/errors"
+
+	validation "github.com/papey08/golang-fintech/validation"
 )
 
-var ErrNotStruct = errors.New("wrong argument given, should be a struct")
-var ErrInvalidValidatorSyntax = errors.New("invalid validator syntax")
-var ErrValidateForUnexportedFields = errors.New("validation for unexported field is not allowed")
+// errors of the shared validation module, so errors.Is works with both
+var ErrNotStruct = validation.ErrNotStruct
+var ErrInvalidValidatorSyntax = validation.ErrInvalidValidatorSyntax
+var ErrValidateForUnexportedFields = validation.ErrValidateForUnexportedFields
 
 type ValidationError struct {
-	Err error
+	Field string // full path to the field, e.g. Items[3].Name
+	Err   error
 }
 
 type ValidationErrors []ValidationError
 
+// Error returns messages of all errors without paths to fields
 func (v ValidationErrors) Error() string {
-	// TODO: implement this
-	return ""
+	msgs := make([]string, len(v))
+	for i, ve := range v {
+		msgs[i] = ve.Err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Validate checks fields of struct v or of struct v points to against their
+// validate tags. Returns ValidationErrors with all failed checks or
+// ErrNotStruct if v is not a struct
+func Validate[T any](v T) error {
+	err := validation.Validate(v)
+	var ve validation.ValidationErrors
+	if !errors.As(err, &ve) {
+		return err
+	}
+
+	res := make(ValidationErrors, len(ve))
+	for i := range ve {
+		res[i] = ValidationError{Field: ve[i].Field, Err: ve[i].Err}
+	}
+	return res
 }
 
-func Validate(v any) error {
-	// TODO: implement this
-	return nil
+// MustValidate returns v if it is valid and panics otherwise
+func MustValidate[T any](v T) T {
+	if err := Validate(v); err != nil {
+		panic(err)
+	}
+	return v
 }
@@ -2,6 +2,7 @@ package homework
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -231,3 +232,30 @@ func TestValidate(t *testing.T) {
 	}
 
 }
+
+type User struct {
+	Name  string   `validate:"min:1;max:20"`
+	Role  string   `validate:"in:admin,user"`
+	Age   int      `validate:"min:18"`
+	Codes []string `validate:"len:2"`
+}
+
+func TestValidateTyped(t *testing.T) {
+	valid := User{Name: "bob", Role: "user", Age: 18, Codes: []string{"ru", "en"}}
+	assert.NoError(t, Validate(valid))
+	assert.NoError(t, Validate(&valid))
+	assert.Equal(t, valid, MustValidate(valid))
+
+	err := Validate(User{Role: "guest", Age: 17, Codes: []string{"ru", "eng"}})
+	var ve ValidationErrors
+	assert.True(t, errors.As(err, &ve))
+	assert.Len(t, ve, 4)
+	for i, field := range []string{"Name", "Role", "Age", "Codes[1]"} {
+		assert.Equal(t, field, ve[i].Field)
+	}
+	assert.Equal(t, strings.Repeat("value of field is not validate\n", 3)+"value of field is not validate", err.Error())
+
+	assert.ErrorIs(t, Validate((*User)(nil)), ErrNotStruct)
+	assert.ErrorIs(t, Validate(42), ErrNotStruct)
+	assert.Panics(t, func() { MustValidate(User{}) })
+}
@@ -0,0 +1,72 @@
+package httpgin
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"homework8/internal/ads"
+	"homework8/internal/domainerr"
+)
+
+type createAdRequest struct {
+	Title  string `json:"title"`
+	Text   string `json:"text"`
+	UserID int64  `json:"user_id"`
+}
+
+type adResponse struct {
+	ID        int64  `json:"id"`
+	Title     string `json:"title"`
+	Text      string `json:"text"`
+	AuthorID  int64  `json:"author_id"`
+	Published bool   `json:"published"`
+}
+
+type changeAdStatusRequest struct {
+	Published bool  `json:"published"`
+	UserID    int64 `json:"user_id"`
+}
+
+type updateAdRequest struct {
+	Title  string `json:"title"`
+	Text   string `json:"text"`
+	UserID int64  `json:"user_id"`
+}
+
+func newAdResponse(ad ads.Ad) adResponse {
+	return adResponse{
+		ID:        ad.ID,
+		Title:     ad.Title,
+		Text:      ad.Text,
+		AuthorID:  ad.AuthorID,
+		Published: ad.Published,
+	}
+}
+
+func AdSuccessResponse(ad *ads.Ad) gin.H {
+	return gin.H{
+		"data":  newAdResponse(*ad),
+		"error": nil,
+	}
+}
+
+func AdsSuccessResponse(list []ads.Ad) gin.H {
+	data := make([]adResponse, 0, len(list))
+	for _, ad := range list {
+		data = append(data, newAdResponse(ad))
+	}
+	return gin.H{
+		"data":  data,
+		"error": nil,
+	}
+}
+
+func AdErrorResponse(err error) gin.H {
+	resp := gin.H{
+		"data":  nil,
+		"error": err.Error(),
+	}
+	if fields := domainerr.Fields(err); fields != nil {
+		resp["fields"] = fields
+	}
+	return resp
+}
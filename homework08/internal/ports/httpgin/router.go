@@ -0,0 +1,14 @@
+package httpgin
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"homework8/internal/app"
+)
+
+func AppRouter(r *gin.RouterGroup, a app.App) {
+	r.POST("/ads", createAd(a))                    // Метод для создания объявления (ad)
+	r.PUT("/ads/:ad_id/status", changeAdStatus(a)) // Метод для изменения статуса объявления (опубликовано - Published = true или снято с публикации Published = false)
+	r.PUT("/ads/:ad_id", updateAd(a))              // Метод для обновления текста(Text) или заголовка(Title) объявления
+	r.GET("/ads", listAds(a))                      // Метод для получения списка опубликованных объявлений
+}
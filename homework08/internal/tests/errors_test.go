@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"homework8/internal/domainerr"
+	"homework8/internal/ports/httperr"
+)
+
+func TestErrorTranslation(t *testing.T) {
+	tests := []struct {
+		err    error
+		status int
+	}{
+		{&domainerr.ValidationError{}, http.StatusBadRequest},
+		{fmt.Errorf("ad 1: %w", domainerr.ErrForbidden), http.StatusForbidden},
+		{fmt.Errorf("ad 1: %w", domainerr.ErrNotFound), http.StatusNotFound},
+		{fmt.Errorf("disk is full"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.status, httperr.Status(tt.err), tt.err.Error())
+	}
+}
+
+func TestChangeStatusOfMissingAd(t *testing.T) {
+	client := getTestClient()
+
+	_, err := client.changeAdStatus(123, 42, true)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestUpdateMissingAd(t *testing.T) {
+	client := getTestClient()
+
+	_, err := client.updateAd(123, 42, "title", "text")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestCreateAd_ValidationFields(t *testing.T) {
+	client := getTestClient()
+
+	data, err := json.Marshal(map[string]any{"user_id": 123, "title": "", "text": "world"})
+	assert.NoError(t, err)
+
+	resp, err := client.client.Post(client.baseURL+"/api/v1/ads", "application/json", bytes.NewReader(data))
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	respBody, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+
+	var response struct {
+		Fields []domainerr.FieldViolation `json:"fields"`
+	}
+	assert.NoError(t, json.Unmarshal(respBody, &response))
+	if assert.Len(t, response.Fields, 1) {
+		assert.Equal(t, "Title", response.Fields[0].Field)
+	}
+}
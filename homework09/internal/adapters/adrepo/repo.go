@@ -0,0 +1,124 @@
+package adrepo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"homework9/internal/ads"
+	"homework9/internal/app"
+	"homework9/internal/domainerr"
+	"homework9/internal/users"
+)
+
+// repository хранит объявления и пользователей в памяти. ID выдаются по
+// порядку и не переиспользуются после удаления
+type repository struct {
+	mu sync.Mutex
+
+	ads      map[int64]ads.Ad
+	nextAdID int64
+
+	users      map[int64]users.User
+	nextUserID int64
+}
+
+func New() app.Repository {
+	return &repository{
+		ads:   make(map[int64]ads.Ad),
+		users: make(map[int64]users.User),
+	}
+}
+
+func (r *repository) AddAd(_ context.Context, ad ads.Ad) (ads.Ad, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ad.ID = r.nextAdID
+	r.nextAdID++
+	r.ads[ad.ID] = ad
+	return ad, nil
+}
+
+func (r *repository) UpdateAd(_ context.Context, id int64, update func(ad *ads.Ad) error) (ads.Ad, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// update меняет копию, поэтому при ошибке объявление остаётся прежним
+	ad, ok := r.ads[id]
+	if !ok {
+		return ads.Ad{}, fmt.Errorf("ad %d: %w", id, domainerr.ErrNotFound)
+	}
+	if err := update(&ad); err != nil {
+		return ads.Ad{}, err
+	}
+	ad.ID = id
+	r.ads[id] = ad
+	return ad, nil
+}
+
+func (r *repository) ListAds(_ context.Context) ([]ads.Ad, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	list := make([]ads.Ad, 0, len(r.ads))
+	for _, ad := range r.ads {
+		list = append(list, ad)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	return list, nil
+}
+
+func (r *repository) DeleteAd(_ context.Context, id int64, check func(ad ads.Ad) error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ad, ok := r.ads[id]
+	if !ok {
+		return fmt.Errorf("ad %d: %w", id, domainerr.ErrNotFound)
+	}
+	if err := check(ad); err != nil {
+		return err
+	}
+	delete(r.ads, id)
+	return nil
+}
+
+func (r *repository) AddUser(_ context.Context, user users.User) (users.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, u := range r.users {
+		if u.Name == user.Name {
+			return users.User{}, fmt.Errorf("user %q: %w", user.Name, domainerr.ErrConflict)
+		}
+	}
+
+	user.ID = r.nextUserID
+	r.nextUserID++
+	r.users[user.ID] = user
+	return user, nil
+}
+
+func (r *repository) GetUser(_ context.Context, id int64) (users.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	user, ok := r.users[id]
+	if !ok {
+		return users.User{}, fmt.Errorf("user %d: %w", id, domainerr.ErrNotFound)
+	}
+	return user, nil
+}
+
+func (r *repository) DeleteUser(_ context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.users[id]; !ok {
+		return fmt.Errorf("user %d: %w", id, domainerr.ErrNotFound)
+	}
+	delete(r.users, id)
+	return nil
+}
@@ -0,0 +1,189 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	validation "github.com/papey08/golang-fintech/validation"
+
+	"homework9/internal/ads"
+	"homework9/internal/domainerr"
+	"homework9/internal/users"
+)
+
+type App interface {
+	// CreateAd создаёт неопубликованное объявление пользователя userID
+	CreateAd(ctx context.Context, title, text string, userID int64) (*ads.Ad, error)
+
+	// ChangeAdStatus публикует объявление или снимает его с публикации,
+	// менять статус может только автор
+	ChangeAdStatus(ctx context.Context, adID, userID int64, published bool) (*ads.Ad, error)
+
+	// UpdateAd меняет заголовок и текст объявления, менять их может только
+	// автор
+	UpdateAd(ctx context.Context, adID, userID int64, title, text string) (*ads.Ad, error)
+
+	// ListAds возвращает опубликованные объявления в порядке создания
+	ListAds(ctx context.Context) ([]ads.Ad, error)
+
+	// DeleteAd удаляет объявление, удалять его может только автор
+	DeleteAd(ctx context.Context, adID, authorID int64) error
+
+	// CreateUser создаёт пользователя, имена пользователей не повторяются
+	CreateUser(ctx context.Context, name string) (*users.User, error)
+
+	// GetUser возвращает пользователя по ID
+	GetUser(ctx context.Context, id int64) (*users.User, error)
+
+	// DeleteUser удаляет пользователя, его объявления остаются
+	DeleteUser(ctx context.Context, id int64) error
+}
+
+type Repository interface {
+	// AddAd сохраняет объявление со следующим по порядку ID и возвращает его
+	AddAd(ctx context.Context, ad ads.Ad) (ads.Ad, error)
+
+	// UpdateAd атомарно применяет update к объявлению с ID id. Если update
+	// вернула ошибку, объявление не меняется. Для несуществующего объявления
+	// возвращается ошибка, оборачивающая domainerr.ErrNotFound
+	UpdateAd(ctx context.Context, id int64, update func(ad *ads.Ad) error) (ads.Ad, error)
+
+	// ListAds возвращает копии всех объявлений в порядке создания
+	ListAds(ctx context.Context) ([]ads.Ad, error)
+
+	// DeleteAd атомарно удаляет объявление с ID id, если check не вернула
+	// ошибку. Для несуществующего объявления возвращается ошибка,
+	// оборачивающая domainerr.ErrNotFound
+	DeleteAd(ctx context.Context, id int64, check func(ad ads.Ad) error) error
+
+	// AddUser сохраняет пользователя со следующим по порядку ID и возвращает
+	// его. Если имя уже занято, возвращается ошибка, оборачивающая
+	// domainerr.ErrConflict
+	AddUser(ctx context.Context, user users.User) (users.User, error)
+
+	// GetUser возвращает пользователя с ID id или ошибку, оборачивающую
+	// domainerr.ErrNotFound
+	GetUser(ctx context.Context, id int64) (users.User, error)
+
+	// DeleteUser удаляет пользователя с ID id или возвращает ошибку,
+	// оборачивающую domainerr.ErrNotFound
+	DeleteUser(ctx context.Context, id int64) error
+}
+
+type app struct {
+	repo Repository
+}
+
+func NewApp(repo Repository) App {
+	return &app{repo: repo}
+}
+
+func (a *app) CreateAd(ctx context.Context, title, text string, userID int64) (*ads.Ad, error) {
+	ad := ads.Ad{Title: title, Text: text, AuthorID: userID}
+	if err := validate(ad); err != nil {
+		return nil, err
+	}
+
+	ad, err := a.repo.AddAd(ctx, ad)
+	if err != nil {
+		return nil, err
+	}
+	return &ad, nil
+}
+
+func (a *app) ChangeAdStatus(ctx context.Context, adID, userID int64, published bool) (*ads.Ad, error) {
+	ad, err := a.repo.UpdateAd(ctx, adID, func(ad *ads.Ad) error {
+		if ad.AuthorID != userID {
+			return fmt.Errorf("ad %d: %w", adID, domainerr.ErrForbidden)
+		}
+		ad.Published = published
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ad, nil
+}
+
+func (a *app) UpdateAd(ctx context.Context, adID, userID int64, title, text string) (*ads.Ad, error) {
+	ad, err := a.repo.UpdateAd(ctx, adID, func(ad *ads.Ad) error {
+		if ad.AuthorID != userID {
+			return fmt.Errorf("ad %d: %w", adID, domainerr.ErrForbidden)
+		}
+		ad.Title, ad.Text = title, text
+		return validate(*ad)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ad, nil
+}
+
+func (a *app) ListAds(ctx context.Context) ([]ads.Ad, error) {
+	all, err := a.repo.ListAds(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	published := make([]ads.Ad, 0, len(all))
+	for _, ad := range all {
+		if ad.Published {
+			published = append(published, ad)
+		}
+	}
+	return published, nil
+}
+
+func (a *app) DeleteAd(ctx context.Context, adID, authorID int64) error {
+	return a.repo.DeleteAd(ctx, adID, func(ad ads.Ad) error {
+		if ad.AuthorID != authorID {
+			return fmt.Errorf("ad %d: %w", adID, domainerr.ErrForbidden)
+		}
+		return nil
+	})
+}
+
+func (a *app) CreateUser(ctx context.Context, name string) (*users.User, error) {
+	user := users.User{Name: name}
+	if err := validate(user); err != nil {
+		return nil, err
+	}
+
+	user, err := a.repo.AddUser(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (a *app) GetUser(ctx context.Context, id int64) (*users.User, error) {
+	user, err := a.repo.GetUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (a *app) DeleteUser(ctx context.Context, id int64) error {
+	return a.repo.DeleteUser(ctx, id)
+}
+
+// validate проверяет поля объявления или пользователя по тегам validate и
+// возвращает ошибки полей как *domainerr.ValidationError
+func validate(v any) error {
+	err := validation.Validate(v)
+	var fieldErrs validation.ValidationErrors
+	if !errors.As(err, &fieldErrs) {
+		return err
+	}
+
+	ve := &domainerr.ValidationError{Fields: make([]domainerr.FieldViolation, 0, len(fieldErrs))}
+	for _, fe := range fieldErrs.Localize(validation.DefaultLocale) {
+		ve.Fields = append(ve.Fields, domainerr.FieldViolation{
+			Field:       fe.Field,
+			Description: fe.Message,
+		})
+	}
+	return ve
+}
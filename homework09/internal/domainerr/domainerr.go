@@ -0,0 +1,54 @@
+// Package domainerr описывает ошибки бизнес-логики сервиса объявлений.
+// Порты переводят их в ответы своего транспорта по errors.Is, поэтому
+// бизнес-логика оборачивает эти ошибки, а не создаёт свои
+package domainerr
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrNotFound   = errors.New("not found")
+	ErrForbidden  = errors.New("forbidden")
+	ErrValidation = errors.New("validation failed")
+	ErrConflict   = errors.New("conflict")
+)
+
+// FieldViolation описывает ошибку в одном поле
+type FieldViolation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+// ValidationError перечисляет ошибки полей, errors.Is(err, ErrValidation)
+// для неё истинно
+type ValidationError struct {
+	Fields []FieldViolation
+}
+
+func (e *ValidationError) Error() string {
+	if len(e.Fields) == 0 {
+		return ErrValidation.Error()
+	}
+
+	parts := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		parts = append(parts, f.Field+": "+f.Description)
+	}
+	return ErrValidation.Error() + ": " + strings.Join(parts, "; ")
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrValidation
+}
+
+// Fields возвращает ошибки полей из err или nil, если err не содержит
+// ValidationError
+func Fields(err error) []FieldViolation {
+	var ve *ValidationError
+	if errors.As(err, &ve) {
+		return ve.Fields
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package grpc
+
+import (
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"homework9/internal/domainerr"
+)
+
+// errorDomain указывается в errdetails.ErrorInfo всех ошибок сервиса
+const errorDomain = "ads"
+
+// codesTable проверяется по порядку, ошибка без совпадений считается
+// внутренней
+var codesTable = []struct {
+	err    error
+	code   codes.Code
+	reason string
+}{
+	{domainerr.ErrValidation, codes.InvalidArgument, "VALIDATION_FAILED"},
+	{domainerr.ErrForbidden, codes.PermissionDenied, "FORBIDDEN"},
+	{domainerr.ErrNotFound, codes.NotFound, "NOT_FOUND"},
+	{domainerr.ErrConflict, codes.AlreadyExists, "ALREADY_EXISTS"},
+}
+
+// Status переводит ошибку бизнес-логики в gRPC-статус. В детали статуса
+// кладётся errdetails.ErrorInfo с причиной, а для ошибок валидации ещё и
+// errdetails.BadRequest с ошибками полей. Ошибки, которые уже являются
+// gRPC-статусом, возвращаются как есть
+func Status(err error) *status.Status {
+	if st, ok := status.FromError(err); ok {
+		return st
+	}
+
+	for _, e := range codesTable {
+		if !errors.Is(err, e.err) {
+			continue
+		}
+
+		st := status.New(e.code, err.Error())
+		info := &errdetails.ErrorInfo{Reason: e.reason, Domain: errorDomain}
+
+		var detailed *status.Status
+		var detailsErr error
+		if fields := domainerr.Fields(err); len(fields) != 0 {
+			detailed, detailsErr = st.WithDetails(info, badRequest(fields))
+		} else {
+			detailed, detailsErr = st.WithDetails(info)
+		}
+		if detailsErr != nil {
+			return st
+		}
+		return detailed
+	}
+	return status.New(codes.Internal, err.Error())
+}
+
+func badRequest(fields []domainerr.FieldViolation) *errdetails.BadRequest {
+	br := &errdetails.BadRequest{
+		FieldViolations: make([]*errdetails.BadRequest_FieldViolation, 0, len(fields)),
+	}
+	for _, f := range fields {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       f.Field,
+			Description: f.Description,
+		})
+	}
+	return br
+}
@@ -0,0 +1,103 @@
+// Package grpc содержит gRPC-порт сервиса объявлений. Ошибки бизнес-логики
+// переводятся в gRPC-статусы через Status
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"homework9/internal/ads"
+	"homework9/internal/app"
+	"homework9/internal/users"
+)
+
+type service struct {
+	UnimplementedAdServiceServer
+	app app.App
+}
+
+func NewService(a app.App) AdServiceServer {
+	return &service{app: a}
+}
+
+func (s *service) CreateAd(ctx context.Context, req *CreateAdRequest) (*AdResponse, error) {
+	ad, err := s.app.CreateAd(ctx, req.GetTitle(), req.GetText(), req.GetUserId())
+	if err != nil {
+		return nil, Status(err).Err()
+	}
+	return newAdResponse(*ad), nil
+}
+
+func (s *service) ChangeAdStatus(ctx context.Context, req *ChangeAdStatusRequest) (*AdResponse, error) {
+	ad, err := s.app.ChangeAdStatus(ctx, req.GetAdId(), req.GetUserId(), req.GetPublished())
+	if err != nil {
+		return nil, Status(err).Err()
+	}
+	return newAdResponse(*ad), nil
+}
+
+func (s *service) UpdateAd(ctx context.Context, req *UpdateAdRequest) (*AdResponse, error) {
+	ad, err := s.app.UpdateAd(ctx, req.GetAdId(), req.GetUserId(), req.GetTitle(), req.GetText())
+	if err != nil {
+		return nil, Status(err).Err()
+	}
+	return newAdResponse(*ad), nil
+}
+
+func (s *service) ListAds(ctx context.Context, _ *emptypb.Empty) (*ListAdResponse, error) {
+	list, err := s.app.ListAds(ctx)
+	if err != nil {
+		return nil, Status(err).Err()
+	}
+
+	resp := &ListAdResponse{List: make([]*AdResponse, 0, len(list))}
+	for _, ad := range list {
+		resp.List = append(resp.List, newAdResponse(ad))
+	}
+	return resp, nil
+}
+
+func (s *service) DeleteAd(ctx context.Context, req *DeleteAdRequest) (*emptypb.Empty, error) {
+	if err := s.app.DeleteAd(ctx, req.GetAdId(), req.GetAuthorId()); err != nil {
+		return nil, Status(err).Err()
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *service) CreateUser(ctx context.Context, req *CreateUserRequest) (*UserResponse, error) {
+	user, err := s.app.CreateUser(ctx, req.GetName())
+	if err != nil {
+		return nil, Status(err).Err()
+	}
+	return newUserResponse(*user), nil
+}
+
+func (s *service) GetUser(ctx context.Context, req *GetUserRequest) (*UserResponse, error) {
+	user, err := s.app.GetUser(ctx, req.GetId())
+	if err != nil {
+		return nil, Status(err).Err()
+	}
+	return newUserResponse(*user), nil
+}
+
+func (s *service) DeleteUser(ctx context.Context, req *DeleteUserRequest) (*emptypb.Empty, error) {
+	if err := s.app.DeleteUser(ctx, req.GetId()); err != nil {
+		return nil, Status(err).Err()
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func newAdResponse(ad ads.Ad) *AdResponse {
+	return &AdResponse{
+		Id:        ad.ID,
+		Title:     ad.Title,
+		Text:      ad.Text,
+		AuthorId:  ad.AuthorID,
+		Published: ad.Published,
+	}
+}
+
+func newUserResponse(user users.User) *UserResponse {
+	return &UserResponse{Id: user.ID, Name: user.Name}
+}
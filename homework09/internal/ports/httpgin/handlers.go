@@ -0,0 +1,175 @@
+package httpgin
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"homework9/internal/app"
+	"homework9/internal/ports/httperr"
+)
+
+// Метод для создания объявления (ad)
+func createAd(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqBody createAdRequest
+		if err := c.ShouldBindJSON(&reqBody); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse(err))
+			return
+		}
+
+		ad, err := a.CreateAd(c, reqBody.Title, reqBody.Text, reqBody.UserID)
+
+		if err != nil {
+			c.JSON(httperr.Status(err), ErrorResponse(err))
+			return
+		}
+		c.JSON(http.StatusOK, AdSuccessResponse(ad))
+	}
+}
+
+// Метод для изменения статуса объявления (опубликовано - Published = true или снято с публикации Published = false)
+func changeAdStatus(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqBody changeAdStatusRequest
+		if err := c.ShouldBindJSON(&reqBody); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse(err))
+			return
+		}
+
+		adID, err := strconv.ParseInt(c.Param("ad_id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse(err))
+			return
+		}
+
+		ad, err := a.ChangeAdStatus(c, adID, reqBody.UserID, reqBody.Published)
+
+		if err != nil {
+			c.JSON(httperr.Status(err), ErrorResponse(err))
+			return
+		}
+		c.JSON(http.StatusOK, AdSuccessResponse(ad))
+	}
+}
+
+// Метод для обновления текста(Text) или заголовка(Title) объявления
+func updateAd(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqBody updateAdRequest
+		if err := c.ShouldBindJSON(&reqBody); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse(err))
+			return
+		}
+
+		adID, err := strconv.ParseInt(c.Param("ad_id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse(err))
+			return
+		}
+
+		ad, err := a.UpdateAd(c, adID, reqBody.UserID, reqBody.Title, reqBody.Text)
+
+		if err != nil {
+			c.JSON(httperr.Status(err), ErrorResponse(err))
+			return
+		}
+		c.JSON(http.StatusOK, AdSuccessResponse(ad))
+	}
+}
+
+// Метод для получения списка опубликованных объявлений
+func listAds(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		list, err := a.ListAds(c)
+
+		if err != nil {
+			c.JSON(httperr.Status(err), ErrorResponse(err))
+			return
+		}
+		c.JSON(http.StatusOK, AdsSuccessResponse(list))
+	}
+}
+
+// Метод для удаления объявления автором
+func deleteAd(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqBody deleteAdRequest
+		if err := c.ShouldBindJSON(&reqBody); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse(err))
+			return
+		}
+
+		adID, err := strconv.ParseInt(c.Param("ad_id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse(err))
+			return
+		}
+
+		err = a.DeleteAd(c, adID, reqBody.UserID)
+
+		if err != nil {
+			c.JSON(httperr.Status(err), ErrorResponse(err))
+			return
+		}
+		c.JSON(http.StatusOK, EmptySuccessResponse())
+	}
+}
+
+// Метод для создания пользователя
+func createUser(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqBody createUserRequest
+		if err := c.ShouldBindJSON(&reqBody); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse(err))
+			return
+		}
+
+		user, err := a.CreateUser(c, reqBody.Name)
+
+		if err != nil {
+			c.JSON(httperr.Status(err), ErrorResponse(err))
+			return
+		}
+		c.JSON(http.StatusOK, UserSuccessResponse(user))
+	}
+}
+
+// Метод для получения пользователя по ID
+func getUser(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse(err))
+			return
+		}
+
+		user, err := a.GetUser(c, userID)
+
+		if err != nil {
+			c.JSON(httperr.Status(err), ErrorResponse(err))
+			return
+		}
+		c.JSON(http.StatusOK, UserSuccessResponse(user))
+	}
+}
+
+// Метод для удаления пользователя
+func deleteUser(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse(err))
+			return
+		}
+
+		err = a.DeleteUser(c, userID)
+
+		if err != nil {
+			c.JSON(httperr.Status(err), ErrorResponse(err))
+			return
+		}
+		c.JSON(http.StatusOK, EmptySuccessResponse())
+	}
+}
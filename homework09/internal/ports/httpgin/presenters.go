@@ -0,0 +1,101 @@
+package httpgin
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"homework9/internal/ads"
+	"homework9/internal/domainerr"
+	"homework9/internal/users"
+)
+
+type createAdRequest struct {
+	Title  string `json:"title"`
+	Text   string `json:"text"`
+	UserID int64  `json:"user_id"`
+}
+
+type adResponse struct {
+	ID        int64  `json:"id"`
+	Title     string `json:"title"`
+	Text      string `json:"text"`
+	AuthorID  int64  `json:"author_id"`
+	Published bool   `json:"published"`
+}
+
+type changeAdStatusRequest struct {
+	Published bool  `json:"published"`
+	UserID    int64 `json:"user_id"`
+}
+
+type updateAdRequest struct {
+	Title  string `json:"title"`
+	Text   string `json:"text"`
+	UserID int64  `json:"user_id"`
+}
+
+func newAdResponse(ad ads.Ad) adResponse {
+	return adResponse{
+		ID:        ad.ID,
+		Title:     ad.Title,
+		Text:      ad.Text,
+		AuthorID:  ad.AuthorID,
+		Published: ad.Published,
+	}
+}
+
+func AdSuccessResponse(ad *ads.Ad) gin.H {
+	return gin.H{
+		"data":  newAdResponse(*ad),
+		"error": nil,
+	}
+}
+
+func AdsSuccessResponse(list []ads.Ad) gin.H {
+	data := make([]adResponse, 0, len(list))
+	for _, ad := range list {
+		data = append(data, newAdResponse(ad))
+	}
+	return gin.H{
+		"data":  data,
+		"error": nil,
+	}
+}
+
+func ErrorResponse(err error) gin.H {
+	resp := gin.H{
+		"data":  nil,
+		"error": err.Error(),
+	}
+	if fields := domainerr.Fields(err); fields != nil {
+		resp["fields"] = fields
+	}
+	return resp
+}
+
+type deleteAdRequest struct {
+	UserID int64 `json:"user_id"`
+}
+
+type createUserRequest struct {
+	Name string `json:"name"`
+}
+
+type userResponse struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+func UserSuccessResponse(user *users.User) gin.H {
+	return gin.H{
+		"data":  userResponse{ID: user.ID, Name: user.Name},
+		"error": nil,
+	}
+}
+
+// EmptySuccessResponse отдаётся на удаление объявлений и пользователей
+func EmptySuccessResponse() gin.H {
+	return gin.H{
+		"data":  nil,
+		"error": nil,
+	}
+}
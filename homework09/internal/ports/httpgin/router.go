@@ -0,0 +1,19 @@
+package httpgin
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"homework9/internal/app"
+)
+
+func AppRouter(r *gin.RouterGroup, a app.App) {
+	r.POST("/ads", createAd(a))                    // Метод для создания объявления (ad)
+	r.PUT("/ads/:ad_id/status", changeAdStatus(a)) // Метод для изменения статуса объявления (опубликовано - Published = true или снято с публикации Published = false)
+	r.PUT("/ads/:ad_id", updateAd(a))              // Метод для обновления текста(Text) или заголовка(Title) объявления
+	r.GET("/ads", listAds(a))                      // Метод для получения списка опубликованных объявлений
+	r.DELETE("/ads/:ad_id", deleteAd(a))           // Метод для удаления объявления автором
+
+	r.POST("/users", createUser(a))            // Метод для создания пользователя
+	r.GET("/users/:user_id", getUser(a))       // Метод для получения пользователя по ID
+	r.DELETE("/users/:user_id", deleteUser(a)) // Метод для удаления пользователя
+}
@@ -12,7 +12,8 @@ func NewHTTPServer(port string, a app.App) *http.Server {
 	handler := gin.New()
 	s := &http.Server{Addr: port, Handler: handler}
 
-	// todo: add your own logic
+	api := handler.Group("/api/v1")
+	AppRouter(api, a)
 
 	return s
 }
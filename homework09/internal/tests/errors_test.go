@@ -0,0 +1,173 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"homework9/internal/domainerr"
+	grpcPort "homework9/internal/ports/grpc"
+	"homework9/internal/ports/httperr"
+)
+
+func TestErrorTranslation(t *testing.T) {
+	tests := []struct {
+		err        error
+		httpStatus int
+		grpcCode   codes.Code
+	}{
+		{&domainerr.ValidationError{}, http.StatusBadRequest, codes.InvalidArgument},
+		{fmt.Errorf("ad 1: %w", domainerr.ErrForbidden), http.StatusForbidden, codes.PermissionDenied},
+		{fmt.Errorf("ad 1: %w", domainerr.ErrNotFound), http.StatusNotFound, codes.NotFound},
+		{fmt.Errorf("user %q: %w", "Oleg", domainerr.ErrConflict), http.StatusConflict, codes.AlreadyExists},
+		{fmt.Errorf("disk is full"), http.StatusInternalServerError, codes.Internal},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.httpStatus, httperr.Status(tt.err), tt.err.Error())
+		assert.Equal(t, tt.grpcCode, grpcPort.Status(tt.err).Code(), tt.err.Error())
+	}
+}
+
+func TestGRPCStatus_ValidationDetails(t *testing.T) {
+	err := &domainerr.ValidationError{Fields: []domainerr.FieldViolation{
+		{Field: "Title", Description: "too short"},
+	}}
+
+	var info *errdetails.ErrorInfo
+	var badRequest *errdetails.BadRequest
+	for _, d := range grpcPort.Status(err).Details() {
+		switch d := d.(type) {
+		case *errdetails.ErrorInfo:
+			info = d
+		case *errdetails.BadRequest:
+			badRequest = d
+		}
+	}
+
+	if assert.NotNil(t, info) {
+		assert.Equal(t, "VALIDATION_FAILED", info.Reason)
+	}
+	if assert.NotNil(t, badRequest) && assert.Len(t, badRequest.FieldViolations, 1) {
+		assert.Equal(t, "Title", badRequest.FieldViolations[0].Field)
+		assert.Equal(t, "too short", badRequest.FieldViolations[0].Description)
+	}
+}
+
+func TestChangeStatusOfMissingAd(t *testing.T) {
+	client := getTestClient()
+
+	_, err := client.changeAdStatus(123, 42, true)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestUpdateMissingAd(t *testing.T) {
+	client := getTestClient()
+
+	_, err := client.updateAd(123, 42, "title", "text")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestDeleteAdOfAnotherUser(t *testing.T) {
+	client := getTestClient()
+
+	resp, err := client.createAd(123, "hello", "world")
+	assert.NoError(t, err)
+
+	err = client.deleteAd(100, resp.Data.ID)
+	assert.ErrorIs(t, err, ErrForbidden)
+
+	err = client.deleteAd(123, resp.Data.ID)
+	assert.NoError(t, err)
+
+	err = client.deleteAd(123, resp.Data.ID)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestCreateUserWithTakenName(t *testing.T) {
+	client := getTestClient()
+
+	_, err := client.createUser("Oleg")
+	assert.NoError(t, err)
+
+	_, err = client.createUser("Oleg")
+	assert.ErrorIs(t, err, ErrConflict)
+}
+
+func TestGetDeletedUser(t *testing.T) {
+	client := getTestClient()
+
+	resp, err := client.createUser("Oleg")
+	assert.NoError(t, err)
+
+	got, err := client.getUser(resp.Data.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "Oleg", got.Data.Name)
+
+	err = client.deleteUser(resp.Data.ID)
+	assert.NoError(t, err)
+
+	_, err = client.getUser(resp.Data.ID)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestCreateAd_ValidationFields(t *testing.T) {
+	client := getTestClient()
+
+	data, err := json.Marshal(map[string]any{"user_id": 123, "title": "", "text": "world"})
+	assert.NoError(t, err)
+
+	resp, err := client.client.Post(client.baseURL+"/api/v1/ads", "application/json", bytes.NewReader(data))
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	respBody, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+
+	var response struct {
+		Fields []domainerr.FieldViolation `json:"fields"`
+	}
+	assert.NoError(t, json.Unmarshal(respBody, &response))
+	if assert.Len(t, response.Fields, 1) {
+		assert.Equal(t, "Title", response.Fields[0].Field)
+	}
+}
+
+func TestGRPCCreateAd_ValidationDetails(t *testing.T) {
+	client := getGRPCClient(t)
+
+	_, err := client.CreateAd(context.Background(), &grpcPort.CreateAdRequest{Title: "", Text: "world", UserId: 123})
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+
+	var badRequest *errdetails.BadRequest
+	for _, d := range st.Details() {
+		if d, ok := d.(*errdetails.BadRequest); ok {
+			badRequest = d
+		}
+	}
+	if assert.NotNil(t, badRequest) && assert.Len(t, badRequest.FieldViolations, 1) {
+		assert.Equal(t, "Title", badRequest.FieldViolations[0].Field)
+	}
+}
+
+func TestGRPCCreateUserWithTakenName(t *testing.T) {
+	client := getGRPCClient(t)
+	ctx := context.Background()
+
+	_, err := client.CreateUser(ctx, &grpcPort.CreateUserRequest{Name: "Oleg"})
+	assert.NoError(t, err)
+
+	_, err = client.CreateUser(ctx, &grpcPort.CreateUserRequest{Name: "Oleg"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
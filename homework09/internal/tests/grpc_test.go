@@ -8,7 +8,10 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"homework9/internal/adapters/adrepo"
 	"homework9/internal/app"
 	grpcPort "homework9/internal/ports/grpc"
@@ -54,3 +57,56 @@ func TestGRRPCCreateUser(t *testing.T) {
 
 	assert.Equal(t, "Oleg", res.Name)
 }
+
+func TestGRPCUsers(t *testing.T) {
+	client := getGRPCClient(t)
+	ctx := context.Background()
+
+	created, err := client.CreateUser(ctx, &grpcPort.CreateUserRequest{Name: "Oleg"})
+	assert.NoError(t, err, "client.CreateUser")
+
+	got, err := client.GetUser(ctx, &grpcPort.GetUserRequest{Id: created.Id})
+	assert.NoError(t, err, "client.GetUser")
+	assert.Equal(t, "Oleg", got.Name)
+
+	_, err = client.DeleteUser(ctx, &grpcPort.DeleteUserRequest{Id: created.Id})
+	assert.NoError(t, err, "client.DeleteUser")
+
+	_, err = client.GetUser(ctx, &grpcPort.GetUserRequest{Id: created.Id})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGRPCAds(t *testing.T) {
+	client := getGRPCClient(t)
+	ctx := context.Background()
+
+	ad, err := client.CreateAd(ctx, &grpcPort.CreateAdRequest{Title: "hello", Text: "world", UserId: 123})
+	assert.NoError(t, err, "client.CreateAd")
+	assert.Equal(t, int64(123), ad.AuthorId)
+	assert.False(t, ad.Published)
+
+	ad, err = client.UpdateAd(ctx, &grpcPort.UpdateAdRequest{AdId: ad.Id, UserId: 123, Title: "привет", Text: "мир"})
+	assert.NoError(t, err, "client.UpdateAd")
+	assert.Equal(t, "привет", ad.Title)
+	assert.Equal(t, "мир", ad.Text)
+
+	ad, err = client.ChangeAdStatus(ctx, &grpcPort.ChangeAdStatusRequest{AdId: ad.Id, UserId: 123, Published: true})
+	assert.NoError(t, err, "client.ChangeAdStatus")
+	assert.True(t, ad.Published)
+
+	list, err := client.ListAds(ctx, &emptypb.Empty{})
+	assert.NoError(t, err, "client.ListAds")
+	if assert.Len(t, list.List, 1) {
+		assert.Equal(t, ad.Id, list.List[0].Id)
+	}
+
+	_, err = client.DeleteAd(ctx, &grpcPort.DeleteAdRequest{AdId: ad.Id, AuthorId: 100})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = client.DeleteAd(ctx, &grpcPort.DeleteAdRequest{AdId: ad.Id, AuthorId: 123})
+	assert.NoError(t, err, "client.DeleteAd")
+
+	list, err = client.ListAds(ctx, &emptypb.Empty{})
+	assert.NoError(t, err, "client.ListAds")
+	assert.Empty(t, list.List)
+}
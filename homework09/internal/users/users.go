@@ -0,0 +1,6 @@
+package users
+
+type User struct {
+	ID   int64
+	Name string `validate:"min:1;max:99"`
+}
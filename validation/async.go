@@ -0,0 +1,94 @@
+package go_course_validation
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"github.com/papey08/golang-fintech/validation/parse"
+)
+
+// DefaultAsyncWorkers is a default number of goroutines which check
+// asynchronous rules of a single value
+const DefaultAsyncWorkers = 8
+
+var asyncWorkers atomic.Int32
+
+// SetMaxAsyncWorkers sets number of goroutines which check asynchronous rules
+// of a single value, non-positive n restores DefaultAsyncWorkers
+func SetMaxAsyncWorkers(n int) {
+	if n <= 0 {
+		n = DefaultAsyncWorkers
+	}
+	asyncWorkers.Store(int32(n))
+}
+
+func maxAsyncWorkers() int {
+	if n := asyncWorkers.Load(); n > 0 {
+		return int(n)
+	}
+	return DefaultAsyncWorkers
+}
+
+// asyncCheck is a check of value against asynchronous rule postponed until
+// the end of traversal
+type asyncCheck struct {
+	value reflect.Value
+	vp    *valuePlan
+	rule  parse.Rule
+	path  string
+}
+
+// runAsync runs postponed checks concurrently and returns their errors in
+// order the checks were postponed. Returns ctx.Err() if ctx is done before
+// all checks are finished
+func (s *validation) runAsync() (ValidationErrors, error) {
+	if len(s.async) == 0 {
+		return nil, nil
+	}
+
+	type result struct {
+		ve     ValidationError
+		failed bool
+	}
+	results := make([]result, len(s.async))
+
+	workers := maxAsyncWorkers()
+	if workers > len(s.async) {
+		workers = len(s.async)
+	}
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				c := &s.async[i]
+				results[i].ve, results[i].failed = checkRuleCtx(s.ctx, c.value, c.vp, c.rule, c.path)
+			}
+		}()
+	}
+
+feed:
+	for i := range s.async {
+		select {
+		case indices <- i:
+		case <-s.ctx.Done():
+			break feed
+		}
+	}
+	close(indices)
+	wg.Wait()
+
+	if err := s.ctx.Err(); err != nil {
+		return nil, err
+	}
+	var validationErrors ValidationErrors
+	for _, r := range results {
+		if r.failed {
+			validationErrors = append(validationErrors, r.ve)
+		}
+	}
+	return validationErrors, nil
+}
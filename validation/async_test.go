@@ -0,0 +1,113 @@
+package go_course_validation
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/papey08/golang-fintech/validation/check"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ctxKey struct{}
+
+// authorRepo is a fake repository of authors used by author_exists rule
+type authorRepo struct {
+	mu      sync.Mutex
+	authors map[int64]bool
+	running int32
+	maxRun  int32
+}
+
+func (r *authorRepo) exists(ctx context.Context, value reflect.Value, _ []string) error {
+	running := atomic.AddInt32(&r.running, 1)
+	defer atomic.AddInt32(&r.running, -1)
+	for {
+		maxRun := atomic.LoadInt32(&r.maxRun)
+		if running <= maxRun || atomic.CompareAndSwapInt32(&r.maxRun, maxRun, running) {
+			break
+		}
+	}
+
+	// the rule is cancelled only if context says so
+	if ctx.Value(ctxKey{}) == "block" {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	select {
+	case <-time.After(time.Millisecond):
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	if value.Kind() != reflect.Int64 {
+		return check.ErrInvalidFieldType
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.authors[value.Int()] {
+		return check.ErrInvalidFieldValue
+	}
+	return nil
+}
+
+type Post struct {
+	Title     string  `validate:"min:1"`
+	AuthorID  int64   `validate:"min:1;author_exists"`
+	Reviewers []int64 `validate:"max:10;dive;author_exists"`
+}
+
+func TestValidateCtx(t *testing.T) {
+	repo := &authorRepo{authors: map[int64]bool{1: true, 2: true, 3: true}}
+	assert.NoError(t, RegisterValidationCtx("author_exists", repo.exists))
+	assert.ErrorIs(t, RegisterValidationCtx("nilCtxFunc", nil), ErrNilValidator)
+
+	SetMaxAsyncWorkers(2)
+	defer SetMaxAsyncWorkers(0)
+
+	ctx := context.Background()
+	assert.NoError(t, ValidateCtx(ctx, Post{Title: "Гараж", AuthorID: 1, Reviewers: []int64{2, 3, 1, 2}}))
+
+	err := ValidateCtx(ctx, &Post{AuthorID: 4, Reviewers: []int64{1, 5, 2, 6}})
+	var ve ValidationErrors
+	assert.True(t, errors.As(err, &ve))
+	assert.Equal(t, []ValidationError{
+		{Field: "Title", Rule: "min", Args: []string{"1"}, Value: "", Err: check.ErrInvalidFieldValue},
+		{Field: "AuthorID", Rule: "author_exists", Value: int64(4), Err: check.ErrInvalidFieldValue},
+		{Field: "Reviewers[1]", Rule: "author_exists", Value: int64(5), Err: check.ErrInvalidFieldValue},
+		{Field: "Reviewers[3]", Rule: "author_exists", Value: int64(6), Err: check.ErrInvalidFieldValue},
+	}, []ValidationError(ve))
+	assert.LessOrEqual(t, atomic.LoadInt32(&repo.maxRun), int32(2))
+
+	// Validate checks asynchronous rules with background context
+	assert.Equal(t, err, Validate(Post{AuthorID: 4, Reviewers: []int64{1, 5, 2, 6}}))
+
+	v, compileErr := Compile[Post]()
+	assert.NoError(t, compileErr)
+	assert.Equal(t, err, v.ValidateCtx(ctx, Post{AuthorID: 4, Reviewers: []int64{1, 5, 2, 6}}))
+}
+
+func TestValidateCtxCancel(t *testing.T) {
+	repo := &authorRepo{authors: map[int64]bool{1: true}}
+	assert.NoError(t, RegisterValidationCtx("author_exists", repo.exists))
+
+	// context is done before validation
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, ValidateCtx(ctx, Post{AuthorID: 1}), context.Canceled)
+	assert.Zero(t, atomic.LoadInt32(&repo.maxRun))
+
+	// context is done during validation
+	ctx, cancel = context.WithCancel(context.WithValue(context.Background(), ctxKey{}, "block"))
+	time.AfterFunc(10*time.Millisecond, cancel)
+	assert.ErrorIs(t, ValidateCtx(ctx, Post{AuthorID: 1, Reviewers: make([]int64, 10)}), context.Canceled)
+
+	ctx, cancel = context.WithTimeout(context.WithValue(context.Background(), ctxKey{}, "block"), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, ValidateCtx(ctx, Post{AuthorID: 1}), context.DeadlineExceeded)
+}
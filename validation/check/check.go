@@ -1,113 +1,334 @@
 package check
 
 import (
+	"context"
+	"math/big"
+	"reflect"
+	"regexp"
 	"strconv"
+	"time"
 
 	"github.com/papey08/golang-fintech/validation/parse"
 
 	"github.com/pkg/errors"
 )
 
-type validatable interface {
-	int | string
-}
-
 var ErrInvalidFieldValue = errors.New("value of field is not validate")
 var ErrInvalidFieldType = errors.New("invalid type of field")
 
 // ValidField checks field if it is complies with validation parameters
 func ValidField(field any, vOp parse.ValidationOperation, args any) error {
+	return ValidValue(reflect.ValueOf(field), parse.Rule{Op: vOp, Args: args})
+}
 
-	if values, ok := field.([]int); ok {
-		return validSlice(values, vOp, args)
+// ValidValue checks value if it is complies with rule. Named types are
+// checked according to their underlying kind, nil pointers are considered
+// valid, every element of slice or array is checked. Values for registered
+// operations are checked by their Func. Required, nonzero and omitempty are
+// applied to pointers, slices and arrays themselves
+func ValidValue(value reflect.Value, rule parse.Rule) error {
+	return ValidValueCtx(context.Background(), value, rule)
+}
+
+// ValidValueCtx is the same as ValidValue but passes ctx to CtxFunc of
+// registered operation
+func ValidValueCtx(ctx context.Context, value reflect.Value, rule parse.Rule) error {
+	// presence is checked for pointers and collections as a whole
+	if parse.IsPresence(rule.Op) {
+		return validPresence(value, rule.Op)
 	}
 
-	if values, ok := field.([]string); ok {
-		return validSlice(values, vOp, args)
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		return ValidValueCtx(ctx, value.Elem(), rule)
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if err := ValidValueCtx(ctx, value.Index(i), rule); err != nil {
+				return err
+			}
+		}
+		return nil
 	}
 
-	if value, ok := field.(int); ok {
-		return validValue(value, vOp, args)
+	if parse.IsCustom(rule.Op) {
+		return validCustom(ctx, value, rule.Op, rule.Args)
 	}
 
-	if value, ok := field.(string); ok {
-		return validValue(value, vOp, args)
+	// lenmode and tz only modify other rules of the tag
+	if rule.Op == parse.LenMode || rule.Op == parse.TimeZone {
+		return nil
 	}
 
-	return ErrInvalidFieldType
+	switch value.Type() {
+	case timeType:
+		return ValidTime(value.Interface().(time.Time), rule)
+	case durationType:
+		return ValidDuration(time.Duration(value.Int()), rule)
+	}
+	if d, ok := asDecimal(value); ok {
+		return ValidDecimal(d, rule)
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		return validString(value.String(), rule)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return validInt(value.Int(), value.Type().Bits(), rule.Op, rule.Args)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return validUint(value.Uint(), value.Type().Bits(), rule.Op, rule.Args)
+
+	case reflect.Float32, reflect.Float64:
+		return validFloat(value.Float(), value.Type().Bits(), rule.Op, rule.Args)
+
+	default:
+		return ErrInvalidFieldType
+	}
 }
 
-// validSlice checks if every element of slice complies with validation parameters
-func validSlice[T validatable](values []T, vOp parse.ValidationOperation, args any) error {
-	for _, v := range values {
-		if err := validValue(v, vOp, args); err != nil {
-			return err
+// ValidString checks string against built-in rule without reflection, it is
+// used by code generated by validategen
+func ValidString(s string, rule parse.Rule) error {
+	return validString(s, rule)
+}
+
+// ValidInt checks signed integer of given bit size against built-in rule
+// without reflection
+func ValidInt(n int64, bitSize int, rule parse.Rule) error {
+	return validInt(n, bitSize, rule.Op, rule.Args)
+}
+
+// ValidUint checks unsigned integer of given bit size against built-in rule
+// without reflection
+func ValidUint(n uint64, bitSize int, rule parse.Rule) error {
+	return validUint(n, bitSize, rule.Op, rule.Args)
+}
+
+// ValidFloat checks float of given bit size against built-in rule without
+// reflection
+func ValidFloat(f float64, bitSize int, rule parse.Rule) error {
+	return validFloat(f, bitSize, rule.Op, rule.Args)
+}
+
+// validString checks if string complies with rule, length of the string is
+// measured in rule.Unit
+func validString(s string, rule parse.Rule) error {
+	var ok bool
+	switch args := rule.Args; rule.Op {
+
+	case parse.Length:
+		ok = validLen(length(s, rule.Unit), args.(int))
+
+	case parse.In:
+		ok = validIn(s, args.([]string))
+
+	case parse.Min:
+		n, isInt := args.(int)
+		if !isInt {
+			return ErrInvalidFieldType
 		}
+		ok = validMin(length(s, rule.Unit), n)
+
+	case parse.Max:
+		n, isInt := args.(int)
+		if !isInt {
+			return ErrInvalidFieldType
+		}
+		ok = validMax(length(s, rule.Unit), n)
+
+	case parse.LenInterval:
+		arg1, arg2 := args.([2]int)[0], args.([2]int)[1]
+		ok = validLenInterval(length(s, rule.Unit), arg1, arg2)
+
+	case parse.Regexp:
+		ok = args.(*regexp.Regexp).MatchString(s)
+
+	case parse.Email, parse.URL, parse.UUID, parse.Phone, parse.Alpha,
+		parse.Alnum, parse.ASCII, parse.Numeric, parse.Hex:
+		ok = validFormat(s, rule.Op)
+
+	case parse.Positive, parse.Scale, parse.Precision, parse.DecMin, parse.DecMax:
+		return validDecimalString(s, rule)
+
+	case parse.Currency:
+		ok = validCurrency(s, args.([]string))
+
+	default:
+		return ErrInvalidFieldType
+	}
+
+	if !ok {
+		return ErrInvalidFieldValue
 	}
 	return nil
 }
 
-// validValue checks if value complies with validation parameters
-func validValue(value any, validateOperation parse.ValidationOperation, args any) error {
+// validInt checks if signed integer of given bit size complies with
+// validation parameters
+func validInt(n int64, bitSize int, validateOperation parse.ValidationOperation, args any) error {
+	// integers are minor units of money for decimal rules
+	if isMoneyRule(validateOperation) {
+		return ValidDecimal(parse.Decimal{Coef: big.NewInt(n)}, parse.Rule{Op: validateOperation, Args: args})
+	}
 
-	if s, isString := value.(string); isString { // checking if string is valid
-		switch validateOperation {
+	var ok bool
+	switch validateOperation {
 
-		case parse.Length:
-			if !validLen(s, args.(int)) {
-				return ErrInvalidFieldValue
-			}
+	case parse.In:
+		intArgs, err := convertArgs(args.([]string), func(s string) (int64, error) {
+			return strconv.ParseInt(s, 10, bitSize)
+		})
+		if err != nil {
+			return err
+		}
+		ok = validIn(n, intArgs)
 
-		case parse.In:
-			if !validIn(s, args.([]string)) {
-				return ErrInvalidFieldValue
-			}
+	case parse.Min:
+		switch arg := args.(type) {
+		case int:
+			ok = validMin(n, int64(arg))
+		case float64:
+			ok = validMin(float64(n), arg)
+		default:
+			return ErrInvalidFieldType
+		}
 
-		case parse.Min:
-			if !validMin(len(s), args.(int)) {
-				return ErrInvalidFieldValue
-			}
+	case parse.Max:
+		switch arg := args.(type) {
+		case int:
+			ok = validMax(n, int64(arg))
+		case float64:
+			ok = validMax(float64(n), arg)
+		default:
+			return ErrInvalidFieldType
+		}
 
-		case parse.Max:
-			if !validMax(len(s), args.(int)) {
-				return ErrInvalidFieldValue
-			}
+	default:
+		return ErrInvalidFieldType
+	}
 
-		case parse.LenInterval:
-			arg1, arg2 := args.([2]int)[0], args.([2]int)[1]
-			if !validLenInterval(s, arg1, arg2) {
-				return ErrInvalidFieldValue
-			}
+	if !ok {
+		return ErrInvalidFieldValue
+	}
+	return nil
+}
+
+// validUint checks if unsigned integer of given bit size complies with
+// validation parameters, negative bounds are always below the value
+func validUint(n uint64, bitSize int, validateOperation parse.ValidationOperation, args any) error {
+	if isMoneyRule(validateOperation) {
+		return ValidDecimal(parse.Decimal{Coef: new(big.Int).SetUint64(n)}, parse.Rule{Op: validateOperation, Args: args})
+	}
+
+	var ok bool
+	switch validateOperation {
+
+	case parse.In:
+		uintArgs, err := convertArgs(args.([]string), func(s string) (uint64, error) {
+			return strconv.ParseUint(s, 10, bitSize)
+		})
+		if err != nil {
+			return err
 		}
+		ok = validIn(n, uintArgs)
 
-	} else if n, isInt := value.(int); isInt { // checking if int is valid
-		switch validateOperation {
-
-		case parse.In:
-			strArgs := args.([]string)
-			intArgs := make([]int, len(strArgs))
-			for i := range strArgs {
-				temp, err := strconv.Atoi(strArgs[i])
-				if err != nil {
-					return ErrInvalidFieldType
-				}
-				intArgs[i] = temp
-			}
+	case parse.Min:
+		switch arg := args.(type) {
+		case int:
+			ok = arg < 0 || validMin(n, uint64(arg))
+		case float64:
+			ok = validMin(float64(n), arg)
+		default:
+			return ErrInvalidFieldType
+		}
 
-			if !validIn(n, intArgs) {
-				return ErrInvalidFieldValue
-			}
+	case parse.Max:
+		switch arg := args.(type) {
+		case int:
+			ok = arg >= 0 && validMax(n, uint64(arg))
+		case float64:
+			ok = validMax(float64(n), arg)
+		default:
+			return ErrInvalidFieldType
+		}
 
-		case parse.Min:
-			if !validMin(n, args.(int)) {
-				return ErrInvalidFieldValue
-			}
+	default:
+		return ErrInvalidFieldType
+	}
+
+	if !ok {
+		return ErrInvalidFieldValue
+	}
+	return nil
+}
 
-		case parse.Max:
-			if !validMax(n, args.(int)) {
-				return ErrInvalidFieldValue
+// validFloat checks if float of given bit size complies with validation
+// parameters
+func validFloat(f float64, bitSize int, validateOperation parse.ValidationOperation, args any) error {
+	var ok bool
+	switch validateOperation {
+
+	case parse.In:
+		floatArgs, err := convertArgs(args.([]string), func(s string) (float64, error) {
+			return strconv.ParseFloat(s, bitSize)
+		})
+		if err != nil {
+			return err
+		}
+		if bitSize == 32 { // value has already lost precision of float64 args
+			for i := range floatArgs {
+				floatArgs[i] = float64(float32(floatArgs[i]))
 			}
 		}
+		ok = validIn(f, floatArgs)
+
+	case parse.Positive:
+		ok = f > 0
+
+	case parse.Min:
+		switch arg := args.(type) {
+		case int:
+			ok = validMin(f, float64(arg))
+		case float64:
+			ok = validMin(f, arg)
+		default:
+			return ErrInvalidFieldType
+		}
+
+	case parse.Max:
+		switch arg := args.(type) {
+		case int:
+			ok = validMax(f, float64(arg))
+		case float64:
+			ok = validMax(f, arg)
+		default:
+			return ErrInvalidFieldType
+		}
+
+	default:
+		return ErrInvalidFieldType
+	}
+
+	if !ok {
+		return ErrInvalidFieldValue
 	}
 	return nil
 }
+
+// convertArgs converts string args of validate tag to the type of field
+func convertArgs[T validatable](args []string, conv func(string) (T, error)) ([]T, error) {
+	res := make([]T, len(args))
+	for i := range args {
+		temp, err := conv(args[i])
+		if err != nil {
+			return nil, ErrInvalidFieldType
+		}
+		res[i] = temp
+	}
+	return res, nil
+}
@@ -0,0 +1,72 @@
+package check
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/papey08/golang-fintech/validation/parse"
+)
+
+// ValidCollection checks slice, array or map itself against rule: len, min,
+// max and lenInterval restrict number of its elements. Nil pointers are
+// considered valid
+func ValidCollection(value reflect.Value, rule parse.Rule) error {
+	return ValidCollectionCtx(context.Background(), value, rule)
+}
+
+// ValidCollectionCtx is the same as ValidCollection but passes ctx to CtxFunc
+// of registered operation
+func ValidCollectionCtx(ctx context.Context, value reflect.Value, rule parse.Rule) error {
+	if parse.IsPresence(rule.Op) {
+		return validPresence(value, rule.Op)
+	}
+
+	value = deref(value)
+	if !value.IsValid() {
+		return nil
+	}
+
+	if parse.IsCustom(rule.Op) {
+		return validCustom(ctx, value, rule.Op, rule.Args)
+	}
+
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+	default:
+		return ErrInvalidFieldType
+	}
+
+	var ok bool
+	switch args := rule.Args; rule.Op {
+	case parse.Length:
+		ok = validLen(value.Len(), args.(int))
+
+	case parse.Min:
+		n, isInt := args.(int)
+		if !isInt {
+			return ErrInvalidFieldType
+		}
+		ok = validMin(value.Len(), n)
+
+	case parse.Max:
+		n, isInt := args.(int)
+		if !isInt {
+			return ErrInvalidFieldType
+		}
+		ok = validMax(value.Len(), n)
+
+	case parse.LenInterval:
+		ok = validLenInterval(value.Len(), args.([2]int)[0], args.([2]int)[1])
+
+	case parse.LenMode, parse.TimeZone:
+		ok = true
+
+	default:
+		return ErrInvalidFieldType
+	}
+
+	if !ok {
+		return ErrInvalidFieldValue
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+package check
+
+import (
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/papey08/golang-fintech/validation/parse"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// ValidCrossField checks value against rule which refers to other field of
+// the same struct. Other is an invalid reflect.Value if it can't be reached,
+// e.g. because of nil pointer on the path to it. Comparisons with nil
+// pointers are skipped as nil pointers are considered valid
+func ValidCrossField(value, other reflect.Value, rule parse.Rule) error {
+	if rule.Op == parse.RequiredIf {
+		cond := rule.Args.(parse.Condition)
+		if !equalsAny(other, cond.Values) || !IsEmpty(value) {
+			return nil
+		}
+		return ErrInvalidFieldValue
+	}
+
+	value, other = deref(value), deref(other)
+	if !value.IsValid() || !other.IsValid() {
+		return nil
+	}
+
+	c, err := compare(value, other)
+	if err != nil {
+		return err
+	}
+
+	var ok bool
+	switch rule.Op {
+	case parse.EqField:
+		ok = c == 0
+	case parse.NeField:
+		ok = c != 0
+	case parse.GtField:
+		ok = c > 0
+	case parse.GteField:
+		ok = c >= 0
+	case parse.LtField:
+		ok = c < 0
+	case parse.LteField:
+		ok = c <= 0
+	default:
+		return ErrInvalidFieldType
+	}
+
+	if !ok {
+		return ErrInvalidFieldValue
+	}
+	return nil
+}
+
+// compare returns -1, 0 or 1 if a is less, equal or greater than b. Numbers of
+// any kinds are compared by value, strings are compared lexicographically,
+// time.Time values are compared as instants
+func compare(a, b reflect.Value) (int, error) {
+	if a.Type() == timeType || b.Type() == timeType {
+		if a.Type() != b.Type() {
+			return 0, ErrInvalidFieldType
+		}
+		at, bt := a.Interface().(time.Time), b.Interface().(time.Time)
+		switch {
+		case at.Before(bt):
+			return -1, nil
+		case at.After(bt):
+			return 1, nil
+		default:
+			return 0, nil
+		}
+	}
+
+	switch ak, bk := kindClass(a.Kind()), kindClass(b.Kind()); {
+	case ak != bk:
+		// signed and unsigned integers are still comparable
+		if ak == reflect.Int && bk == reflect.Uint {
+			if a.Int() < 0 {
+				return -1, nil
+			}
+			return compareOrdered(uint64(a.Int()), b.Uint()), nil
+		}
+		if ak == reflect.Uint && bk == reflect.Int {
+			if b.Int() < 0 {
+				return 1, nil
+			}
+			return compareOrdered(a.Uint(), uint64(b.Int())), nil
+		}
+		if ak == reflect.Float64 && (bk == reflect.Int || bk == reflect.Uint) ||
+			bk == reflect.Float64 && (ak == reflect.Int || ak == reflect.Uint) {
+			return compareOrdered(toFloat(a), toFloat(b)), nil
+		}
+		return 0, ErrInvalidFieldType
+	case ak == reflect.Int:
+		return compareOrdered(a.Int(), b.Int()), nil
+	case ak == reflect.Uint:
+		return compareOrdered(a.Uint(), b.Uint()), nil
+	case ak == reflect.Float64:
+		return compareOrdered(a.Float(), b.Float()), nil
+	case ak == reflect.String:
+		return compareOrdered(a.String(), b.String()), nil
+	case ak == reflect.Bool:
+		if a.Bool() == b.Bool() {
+			return 0, nil
+		} else if b.Bool() {
+			return -1, nil
+		}
+		return 1, nil
+	default:
+		return 0, ErrInvalidFieldType
+	}
+}
+
+// kindClass joins integer and float kinds of different sizes into reflect.Int,
+// reflect.Uint and reflect.Float64
+func kindClass(k reflect.Kind) reflect.Kind {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.Int
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return reflect.Uint
+	case reflect.Float32, reflect.Float64:
+		return reflect.Float64
+	default:
+		return k
+	}
+}
+
+func toFloat(v reflect.Value) float64 {
+	switch kindClass(v.Kind()) {
+	case reflect.Int:
+		return float64(v.Int())
+	case reflect.Uint:
+		return float64(v.Uint())
+	default:
+		return v.Float()
+	}
+}
+
+func compareOrdered[T ordered](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// equalsAny reports whether v equals one of args of validate tag converted to
+// the type of v
+func equalsAny(v reflect.Value, args []string) bool {
+	v = deref(v)
+	if !v.IsValid() {
+		return false
+	}
+	for _, arg := range args {
+		if equalsArg(v, arg) {
+			return true
+		}
+	}
+	return false
+}
+
+func equalsArg(v reflect.Value, arg string) bool {
+	switch kindClass(v.Kind()) {
+	case reflect.String:
+		return v.String() == arg
+	case reflect.Bool:
+		b, err := strconv.ParseBool(arg)
+		return err == nil && v.Bool() == b
+	case reflect.Int:
+		n, err := strconv.ParseInt(arg, 10, 64)
+		return err == nil && v.Int() == n
+	case reflect.Uint:
+		n, err := strconv.ParseUint(arg, 10, 64)
+		return err == nil && v.Uint() == n
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(arg, v.Type().Bits())
+		return err == nil && v.Float() == f
+	default:
+		return false
+	}
+}
+
+// deref follows pointers and interfaces and returns invalid reflect.Value for
+// nil ones
+func deref(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
@@ -0,0 +1,66 @@
+package check
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	"github.com/papey08/golang-fintech/validation/parse"
+)
+
+// Func checks value against args of registered operation. It should return
+// ErrInvalidFieldValue if value doesn't comply with args and
+// ErrInvalidFieldType if type of value is not supported
+type Func func(value reflect.Value, args []string) error
+
+// CtxFunc is the same as Func but receives context of the validation, so it
+// may call databases or other services. Such operations are asynchronous
+type CtxFunc func(ctx context.Context, value reflect.Value, args []string) error
+
+// customFuncs contains functions of operations added by parse.RegisterOperation
+var customFuncs = struct {
+	sync.RWMutex
+	byOperation map[parse.ValidationOperation]CtxFunc
+	async       map[parse.ValidationOperation]bool
+}{
+	byOperation: make(map[parse.ValidationOperation]CtxFunc),
+	async:       make(map[parse.ValidationOperation]bool),
+}
+
+// Register sets function which checks values for registered operation
+func Register(vOp parse.ValidationOperation, fn Func) {
+	customFuncs.Lock()
+	defer customFuncs.Unlock()
+	customFuncs.byOperation[vOp] = func(_ context.Context, value reflect.Value, args []string) error {
+		return fn(value, args)
+	}
+	delete(customFuncs.async, vOp)
+}
+
+// RegisterCtx sets function which checks values for registered asynchronous
+// operation
+func RegisterCtx(vOp parse.ValidationOperation, fn CtxFunc) {
+	customFuncs.Lock()
+	defer customFuncs.Unlock()
+	customFuncs.byOperation[vOp] = fn
+	customFuncs.async[vOp] = true
+}
+
+// IsAsync reports whether function of registered operation receives context
+func IsAsync(vOp parse.ValidationOperation) bool {
+	customFuncs.RLock()
+	defer customFuncs.RUnlock()
+	return customFuncs.async[vOp]
+}
+
+// validCustom checks value with function of registered operation
+func validCustom(ctx context.Context, value reflect.Value, vOp parse.ValidationOperation, args any) error {
+	customFuncs.RLock()
+	fn, ok := customFuncs.byOperation[vOp]
+	customFuncs.RUnlock()
+	if !ok {
+		return ErrInvalidFieldType
+	}
+	strArgs, _ := args.([]string)
+	return fn(ctx, value, strArgs)
+}
@@ -0,0 +1,66 @@
+package check
+
+import (
+	"net/mail"
+	"net/url"
+	"regexp"
+	"unicode"
+
+	"github.com/papey08/golang-fintech/validation/parse"
+)
+
+var uuidRegexp = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// phoneRegexp matches phone numbers in E.164 format
+var phoneRegexp = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+// validFormat checks if string has format required by operation
+func validFormat(s string, validateOperation parse.ValidationOperation) bool {
+	switch validateOperation {
+	case parse.Email:
+		return validEmail(s)
+	case parse.URL:
+		return validURL(s)
+	case parse.UUID:
+		return uuidRegexp.MatchString(s)
+	case parse.Phone:
+		return phoneRegexp.MatchString(s)
+	case parse.Alpha:
+		return s != "" && consistsOf(s, unicode.IsLetter)
+	case parse.Alnum:
+		return s != "" && consistsOf(s, func(r rune) bool {
+			return unicode.IsLetter(r) || unicode.IsDigit(r)
+		})
+	case parse.ASCII:
+		return consistsOf(s, func(r rune) bool { return r <= unicode.MaxASCII })
+	case parse.Numeric:
+		return s != "" && consistsOf(s, func(r rune) bool { return '0' <= r && r <= '9' })
+	case parse.Hex:
+		return s != "" && consistsOf(s, func(r rune) bool {
+			return '0' <= r && r <= '9' || 'a' <= r && r <= 'f' || 'A' <= r && r <= 'F'
+		})
+	default:
+		return false
+	}
+}
+
+// validEmail checks if s is a bare address like user@example.com
+func validEmail(s string) bool {
+	addr, err := mail.ParseAddress(s)
+	return err == nil && addr.Name == "" && addr.Address == s
+}
+
+// validURL checks if s is an absolute URL with scheme and host
+func validURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && u.Scheme != "" && u.Host != ""
+}
+
+func consistsOf(s string, f func(r rune) bool) bool {
+	for _, r := range s {
+		if !f(r) {
+			return false
+		}
+	}
+	return true
+}
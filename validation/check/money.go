@@ -0,0 +1,210 @@
+package check
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+
+	"github.com/papey08/golang-fintech/validation/parse"
+)
+
+// Decimal is implemented by types of exact decimal numbers, value of the
+// number is Coefficient() * 10^Exponent(). Decimal of
+// github.com/shopspring/decimal implements it
+type Decimal interface {
+	Coefficient() *big.Int
+	Exponent() int32
+}
+
+var decimalType = reflect.TypeOf((*Decimal)(nil)).Elem()
+
+// asDecimal returns value as Decimal if its type or pointer to it implements
+// the interface
+func asDecimal(value reflect.Value) (Decimal, bool) {
+	if !value.CanInterface() {
+		return nil, false
+	}
+	if value.Type().Implements(decimalType) {
+		d, ok := value.Interface().(Decimal)
+		return d, ok
+	}
+	if value.CanAddr() && value.Addr().Type().Implements(decimalType) {
+		return value.Addr().Interface().(Decimal), true
+	}
+	if reflect.PointerTo(value.Type()).Implements(decimalType) {
+		ptr := reflect.New(value.Type())
+		ptr.Elem().Set(value)
+		return ptr.Interface().(Decimal), true
+	}
+	return nil, false
+}
+
+// isMoneyRule reports whether rule checks value of number as decimal
+func isMoneyRule(op parse.ValidationOperation) bool {
+	switch op {
+	case parse.Positive, parse.Scale, parse.Precision, parse.DecMin, parse.DecMax:
+		return true
+	}
+	return false
+}
+
+// ValidDecimal checks exact decimal number d against rule: positive, scale,
+// precision, decmin and decmax. Min and max compare d with their args exactly
+// too. Trailing zeros of fractional part are not counted by scale and
+// precision, so "1.50" satisfies scale:1
+func ValidDecimal(d Decimal, rule parse.Rule) error {
+	value := parse.Decimal{Coef: d.Coefficient(), Exp: d.Exponent()}
+	if value.Coef == nil {
+		value.Coef = new(big.Int)
+	}
+
+	var ok bool
+	switch args := rule.Args; rule.Op {
+	case parse.Positive:
+		ok = value.Coef.Sign() > 0
+
+	case parse.Scale:
+		ok = scale(value) <= int64(args.(int))
+
+	case parse.Precision:
+		ok = precision(value) <= int64(args.(int))
+
+	case parse.DecMin:
+		ok = compareDecimals(value, args.(parse.Decimal)) >= 0
+
+	case parse.DecMax:
+		ok = compareDecimals(value, args.(parse.Decimal)) <= 0
+
+	case parse.Min, parse.Max:
+		bound, isDecimal := decimalBound(rule)
+		if !isDecimal {
+			return ErrInvalidFieldType
+		}
+		cmp := compareDecimals(value, bound)
+		ok = rule.Op == parse.Min && cmp >= 0 || rule.Op == parse.Max && cmp <= 0
+
+	default:
+		return ErrInvalidFieldType
+	}
+
+	if !ok {
+		return ErrInvalidFieldValue
+	}
+	return nil
+}
+
+// decimalBound returns bound of min or max rule as decimal. Bound is taken as
+// it is written in tag to avoid rounding of float, rules built without tag
+// use their args
+func decimalBound(rule parse.Rule) (parse.Decimal, bool) {
+	if len(rule.Params) != 0 {
+		return parse.ParseDecimal(rule.Params[0])
+	}
+	switch arg := rule.Args.(type) {
+	case int:
+		return parse.Decimal{Coef: big.NewInt(int64(arg))}, true
+	case float64:
+		return parse.ParseDecimal(strconv.FormatFloat(arg, 'f', -1, 64))
+	}
+	return parse.Decimal{}, false
+}
+
+// validDecimalString checks decimal number written in s against rule,
+// strings which are not decimal numbers are invalid
+func validDecimalString(s string, rule parse.Rule) error {
+	d, ok := parse.ParseDecimal(s)
+	if !ok {
+		return ErrInvalidFieldValue
+	}
+	return ValidDecimal(d, rule)
+}
+
+// validCurrency checks if code is a known ISO 4217 code allowed by args of
+// currency rule, nil args allow any known code
+func validCurrency(code string, args []string) bool {
+	if !parse.IsCurrency(code) {
+		return false
+	}
+	return args == nil || validIn(code, args)
+}
+
+// normalize removes trailing zeros of coefficient of d
+func normalize(d parse.Decimal) parse.Decimal {
+	if d.Coef.Sign() == 0 {
+		return parse.Decimal{Coef: d.Coef}
+	}
+	ten := big.NewInt(10)
+	q, r := new(big.Int), new(big.Int)
+	for {
+		q.QuoRem(d.Coef, ten, r)
+		if r.Sign() != 0 || d.Exp == math.MaxInt32 {
+			return d
+		}
+		d = parse.Decimal{Coef: new(big.Int).Set(q), Exp: d.Exp + 1}
+	}
+}
+
+// digits returns number of digits of coefficient of d
+func digits(d parse.Decimal) int64 {
+	return int64(len(new(big.Int).Abs(d.Coef).String()))
+}
+
+// scale returns number of significant fractional digits of d
+func scale(d parse.Decimal) int64 {
+	if d = normalize(d); d.Exp >= 0 {
+		return 0
+	}
+	return -int64(d.Exp)
+}
+
+// precision returns number of digits needed to write d without trailing
+// zeros of fractional part, e.g. 3 for 12.3 and 0.001
+func precision(d parse.Decimal) int64 {
+	d = normalize(d)
+	if d.Exp >= 0 {
+		return digits(d) + int64(d.Exp)
+	}
+	if s := -int64(d.Exp); s > digits(d) {
+		return s
+	}
+	return digits(d)
+}
+
+// compareDecimals returns -1, 0 or 1 if a is less than, equal to or greater
+// than b. Numbers are compared by position of their first digit before
+// exponents are aligned, so huge exponents don't produce huge coefficients
+func compareDecimals(a, b parse.Decimal) int {
+	a, b = normalize(a), normalize(b)
+	if sa, sb := a.Coef.Sign(), b.Coef.Sign(); sa != sb || sa == 0 {
+		return compareInts(int64(sa), int64(sb))
+	}
+
+	// the first digit of larger number is in higher position
+	if pa, pb := digits(a)+int64(a.Exp), digits(b)+int64(b.Exp); pa != pb {
+		return compareInts(pa, pb) * a.Coef.Sign()
+	}
+
+	ca, cb := a.Coef, b.Coef
+	if a.Exp > b.Exp {
+		ca = new(big.Int).Mul(ca, pow10(int64(a.Exp)-int64(b.Exp)))
+	} else {
+		cb = new(big.Int).Mul(cb, pow10(int64(b.Exp)-int64(a.Exp)))
+	}
+	return ca.Cmp(cb)
+}
+
+func pow10(n int64) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(n), nil)
+}
+
+func compareInts(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
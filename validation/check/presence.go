@@ -0,0 +1,48 @@
+package check
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/papey08/golang-fintech/validation/parse"
+)
+
+// IsEmpty reports whether value is absent: it is a nil pointer, interface or
+// func, an empty string, slice, map or channel, a number equal to 0, false,
+// a zero time.Time or a zero value of other type. Pointer to zero value is
+// not empty
+func IsEmpty(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Pointer, reflect.Interface:
+		return value.IsNil()
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Chan:
+		return value.Len() == 0
+	case reflect.Float32, reflect.Float64:
+		return value.Float() == 0 // -0 is empty too
+	case reflect.Complex64, reflect.Complex128:
+		return value.Complex() == 0
+	}
+	if value.Type() == timeType && value.CanInterface() {
+		return value.Interface().(time.Time).IsZero()
+	}
+	return value.IsZero()
+}
+
+// validPresence checks value as a whole against required, nonzero or
+// omitempty rule. Omitempty always succeeds, since it only makes the other
+// rules skip empty values
+func validPresence(value reflect.Value, op parse.ValidationOperation) error {
+	switch op {
+	case parse.Required:
+		if IsEmpty(value) {
+			return ErrInvalidFieldValue
+		}
+	case parse.NonZero:
+		if IsEmpty(deref(value)) {
+			return ErrInvalidFieldValue
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package check
+
+import (
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/papey08/golang-fintech/validation/parse"
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// ValidTime checks moment t against rule: before and after compare it with
+// date or the current time, within restricts its distance from the current
+// time and weekday restricts day of week in location of the rule
+func ValidTime(t time.Time, rule parse.Rule) error {
+	loc := rule.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	var ok bool
+	switch args := rule.Args; rule.Op {
+	case parse.Before:
+		ok = t.Before(args.(parse.Moment).At(time.Now(), loc))
+
+	case parse.After:
+		ok = t.After(args.(parse.Moment).At(time.Now(), loc))
+
+	case parse.Within:
+		d := time.Since(t)
+		ok = d <= args.(time.Duration) && d >= -args.(time.Duration)
+
+	case parse.Weekday:
+		day := t.In(loc).Weekday()
+		for _, allowed := range args.([]time.Weekday) {
+			if day == allowed {
+				ok = true
+				break
+			}
+		}
+
+	default:
+		return ErrInvalidFieldType
+	}
+
+	if !ok {
+		return ErrInvalidFieldValue
+	}
+	return nil
+}
+
+// ValidDuration checks duration d against rule: min, max and in accept
+// durations like 1h30m and numbers of nanoseconds
+func ValidDuration(d time.Duration, rule parse.Rule) error {
+	var ok bool
+	switch args := rule.Args; rule.Op {
+	case parse.In:
+		durations, err := convertArgs(args.([]string), parseDuration)
+		if err != nil {
+			return err
+		}
+		ok = validIn(int64(d), durations)
+
+	case parse.Min:
+		switch arg := args.(type) {
+		case time.Duration:
+			ok = validMin(int64(d), int64(arg))
+		default:
+			return validInt(int64(d), 64, rule.Op, args)
+		}
+
+	case parse.Max:
+		switch arg := args.(type) {
+		case time.Duration:
+			ok = validMax(int64(d), int64(arg))
+		default:
+			return validInt(int64(d), 64, rule.Op, args)
+		}
+
+	default:
+		return ErrInvalidFieldType
+	}
+
+	if !ok {
+		return ErrInvalidFieldValue
+	}
+	return nil
+}
+
+// parseDuration converts arg of in rule to nanoseconds
+func parseDuration(s string) (int64, error) {
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return n, nil
+	}
+	d, err := time.ParseDuration(s)
+	return int64(d), err
+}
@@ -1,7 +1,35 @@
 package check
 
-func validLen(s string, n int) bool {
-	return len(s) == n
+import (
+	"unicode/utf8"
+
+	"github.com/papey08/golang-fintech/validation/parse"
+
+	"github.com/rivo/uniseg"
+)
+
+type validatable interface {
+	int64 | uint64 | float64 | string
+}
+
+type ordered interface {
+	int | int64 | uint64 | float64 | string
+}
+
+// length returns length of s measured in unit
+func length(s string, unit parse.LengthUnit) int {
+	switch unit {
+	case parse.Bytes:
+		return len(s)
+	case parse.Graphemes:
+		return uniseg.GraphemeClusterCount(s)
+	default:
+		return utf8.RuneCountInString(s)
+	}
+}
+
+func validLen(l int, n int) bool {
+	return l == n
 }
 
 func validIn[T validatable](val T, vals []T) bool {
@@ -13,14 +41,14 @@ func validIn[T validatable](val T, vals []T) bool {
 	return false
 }
 
-func validMin(n int, min int) bool {
+func validMin[T ordered](n T, min T) bool {
 	return n >= min
 }
 
-func validMax(n int, max int) bool {
+func validMax[T ordered](n T, max T) bool {
 	return n <= max
 }
 
-func validLenInterval(s string, a, b int) bool {
-	return a <= len(s) && len(s) <= b
+func validLenInterval(l int, a, b int) bool {
+	return a <= l && l <= b
 }
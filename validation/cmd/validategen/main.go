@@ -0,0 +1,47 @@
+// Command validategen generates Validate methods of structs with validate tags
+// which check the same rules as validation.Validate without reflection.
+// Usage in a package:
+//
+//	//go:generate go run github.com/papey08/golang-fintech/validation/cmd/validategen
+//
+// Flags:
+//
+//	-type   comma-separated names of structs, by default all structs with
+//	        validate tags
+//	-output name of the generated file, validate_gen.go by default
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/papey08/golang-fintech/validation/internal/validategen"
+)
+
+func main() {
+	typeNames := flag.String("type", "", "comma-separated names of structs")
+	output := flag.String("output", "validate_gen.go", "name of the generated file")
+	flag.Parse()
+
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+	var names []string
+	if *typeNames != "" {
+		names = strings.Split(*typeNames, ",")
+	}
+
+	src, err := validategen.Generate(dir, names)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "validategen:", err)
+		os.Exit(1)
+	}
+	if err = os.WriteFile(filepath.Join(dir, *output), src, 0o644); err != nil {
+		fmt.Fprintln(os.Stderr, "validategen:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,69 @@
+package go_course_validation_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	validation "github.com/papey08/golang-fintech/validation"
+	"github.com/papey08/golang-fintech/validation/internal/validategen"
+	"github.com/papey08/golang-fintech/validation/testdata/conformance"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// conformance is imported by the external test package, since generated code
+// imports validation
+
+// TestGeneratedConformance checks validation.Validate on every case and
+// compares it with generated Validate method if the value has one
+func TestGeneratedConformance(t *testing.T) {
+	for _, c := range conformance.Cases {
+		t.Run(c.Name, func(t *testing.T) {
+			want := validation.Validate(c.Value)
+			if !c.WantErr {
+				assert.NoError(t, want)
+			} else if assert.Error(t, want) && c.Check != nil {
+				c.Check(t, want)
+			}
+
+			if generated, ok := c.Value.(interface{ Validate() error }); ok {
+				assert.Equal(t, want, generated.Validate())
+			}
+		})
+	}
+}
+
+func TestGeneratedIsNotCalledAsHook(t *testing.T) {
+	err := validation.Validate(&conformance.Item{Price: -1})
+	assert.Len(t, err.(validation.ValidationErrors), 2)
+
+	// hand-written ValidateContext is still called once
+	err = validation.Validate(&conformance.Account{Login: "abc", Balance: -1})
+	assert.Len(t, err.(validation.ValidationErrors), 1)
+}
+
+func TestGeneratedIsUpToDate(t *testing.T) {
+	dir := filepath.Join("testdata", "conformance")
+	src, err := validategen.Generate(dir, nil)
+	require.NoError(t, err)
+
+	generated, err := os.ReadFile(filepath.Join(dir, "validate_gen.go"))
+	require.NoError(t, err)
+	assert.Equal(t, string(generated), string(src), "run go generate ./testdata/conformance")
+}
+
+func TestGenerateTypes(t *testing.T) {
+	dir := filepath.Join("testdata", "conformance")
+	_, err := validategen.Generate(dir, []string{"Checked"})
+	assert.ErrorIs(t, err, validategen.ErrHasValidate)
+
+	_, err = validategen.Generate(dir, []string{"Title"})
+	assert.ErrorIs(t, err, validategen.ErrInvalidType)
+
+	src, err := validategen.Generate(dir, []string{"Item"})
+	require.NoError(t, err)
+	assert.Contains(t, string(src), "func (x *Item) Validate() error")
+	assert.NotContains(t, string(src), "func (x *Ad) Validate() error")
+}
@@ -0,0 +1,57 @@
+package go_course_validation
+
+import (
+	"github.com/papey08/golang-fintech/validation/check"
+	"github.com/papey08/golang-fintech/validation/parse"
+
+	"github.com/pkg/errors"
+)
+
+var ErrNilValidator = errors.New("validator function is nil")
+
+// ValidatorFunc checks value of field against args of custom rule. Pointers
+// are dereferenced and slices are split into elements before the call, so
+// value is never a nil pointer or a slice. Args are passed as they are
+// written in tag, e.g. ["RU", "US"] for "country:RU,US"
+type ValidatorFunc = check.Func
+
+// ValidatorCtxFunc is the same as ValidatorFunc but receives context passed to
+// ValidateCtx, so it may call repositories or other services. It should
+// return ctx.Err() when ctx is done
+type ValidatorCtxFunc = check.CtxFunc
+
+// RegisterValidation adds rule with given name which can be used in validate
+// tags. Names of built-in rules can't be registered, registering the same
+// name again replaces its function
+func RegisterValidation(name string, fn ValidatorFunc) error {
+	if fn == nil {
+		return ErrNilValidator
+	}
+	op, err := parse.RegisterOperation(name)
+	if err != nil {
+		return err
+	}
+	check.Register(op, fn)
+
+	// tags with this name might have been cached as invalid
+	resetPlans()
+	return nil
+}
+
+// RegisterValidationCtx adds asynchronous rule with given name. Such rules of
+// the validated value are checked after all the others concurrently by at most
+// SetMaxAsyncWorkers goroutines, their errors follow errors of the other rules
+func RegisterValidationCtx(name string, fn ValidatorCtxFunc) error {
+	if fn == nil {
+		return ErrNilValidator
+	}
+	op, err := parse.RegisterOperation(name)
+	if err != nil {
+		return err
+	}
+	check.RegisterCtx(op, fn)
+
+	// tags with this name might have been cached as invalid
+	resetPlans()
+	return nil
+}
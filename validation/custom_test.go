@@ -0,0 +1,94 @@
+package go_course_validation
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/papey08/golang-fintech/validation/check"
+	"github.com/papey08/golang-fintech/validation/parse"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func luhn(value reflect.Value, _ []string) error {
+	if value.Kind() != reflect.String {
+		return check.ErrInvalidFieldType
+	}
+	s := value.String()
+	if s == "" {
+		return check.ErrInvalidFieldValue
+	}
+	sum := 0
+	for i := range s {
+		d := int(s[len(s)-1-i] - '0')
+		if d < 0 || d > 9 {
+			return check.ErrInvalidFieldValue
+		}
+		if i%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	if sum%10 != 0 {
+		return check.ErrInvalidFieldValue
+	}
+	return nil
+}
+
+func country(value reflect.Value, args []string) error {
+	if value.Kind() != reflect.String {
+		return check.ErrInvalidFieldType
+	}
+	for _, arg := range args {
+		if value.String() == arg {
+			return nil
+		}
+	}
+	return check.ErrInvalidFieldValue
+}
+
+func TestRegisterValidation(t *testing.T) {
+	assert.NoError(t, RegisterValidation("luhn", luhn))
+	assert.NoError(t, RegisterValidation("country", country))
+
+	assert.ErrorIs(t, RegisterValidation("min", luhn), parse.ErrOperationExists)
+	assert.ErrorIs(t, RegisterValidation("currency", country), parse.ErrOperationExists)
+	assert.ErrorIs(t, RegisterValidation("bad:name", luhn), parse.ErrInvalidOperationName)
+	assert.ErrorIs(t, RegisterValidation("", luhn), parse.ErrInvalidOperationName)
+	assert.ErrorIs(t, RegisterValidation("nilFunc", nil), ErrNilValidator)
+
+	type Payment struct {
+		Card      string   `validate:"luhn"`
+		Country   string   `validate:"country:RU,US"`
+		Countries []string `validate:"country:RU,US;min:2"`
+		Backup    *string  `validate:"luhn"`
+	}
+
+	assert.NoError(t, Validate(Payment{
+		Card:      "4561261212345467",
+		Country:   "RU",
+		Countries: []string{"US", "RU"},
+	}))
+
+	err := Validate(Payment{
+		Card:      "4561261212345464",
+		Country:   "DE",
+		Countries: []string{"US", "GB"},
+	})
+	var ve ValidationErrors
+	assert.True(t, errors.As(err, &ve))
+	assert.Equal(t, []ValidationError{
+		{Field: "Card", Rule: "luhn", Value: "4561261212345464", Err: check.ErrInvalidFieldValue},
+		{Field: "Country", Rule: "country", Args: []string{"RU", "US"}, Value: "DE", Err: check.ErrInvalidFieldValue},
+		{Field: "Countries[1]", Rule: "country", Args: []string{"RU", "US"}, Value: "GB", Err: check.ErrInvalidFieldValue},
+	}, []ValidationError(ve))
+
+	err = Validate(struct {
+		Amount int64 `validate:"luhn"`
+	}{Amount: 100})
+	assert.ErrorIs(t, err, check.ErrInvalidFieldType)
+}
@@ -0,0 +1,128 @@
+package go_course_validation
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/papey08/golang-fintech/validation/check"
+	"github.com/papey08/golang-fintech/validation/parse"
+)
+
+// fuzzTags contains tags with every kind of rule to seed fuzzing
+var fuzzTags = []string{
+	"len:3",
+	"in:a,'b,c',3",
+	"min:-1;max:2.5",
+	"lenInterval:1,5 lenmode:graphemes",
+	"regexp:'^[a-z]+$'",
+	"email;url;uuid;phone;alpha;alnum;ascii;numeric;hex",
+	"eqfield:S;nefield:N;gtfield:P.Price;gtefield:F;ltfield:U;ltefield:T",
+	"required_if:N,1,2",
+	"before:now-1h;after:2020-01-01 within:720h",
+	"weekday:sat,sun;tz:Europe/Moscow;min:1h30m;in:1h,60",
+	"positive;scale:2;precision:18;decmin:-1.5;decmax:100",
+	"currency;currency:ISO4217;currency:RUB,USD",
+	"required;nonzero;min:1",
+	"omitempty;email;dive;omitempty;nonzero",
+	"omitempty;required",
+	"min:1;dive;keys;max:2;endkeys;min:0",
+	"dive;dive;len:1",
+	"keys;endkeys;dive",
+	"'unterminated",
+	"",
+}
+
+// fuzzValues returns values of different kinds made of fuzzed s, n and x
+func fuzzValues(s string, n int64, x float64) []any {
+	i := any(s)
+	return []any{
+		s,
+		Title(s),
+		n,
+		uint8(n),
+		Code(n),
+		float32(x),
+		x,
+		n%2 == 0,
+		&i,
+		(*Item)(nil),
+		&Item{Name: s, Price: int(n)},
+		time.Unix(n, 0),
+		time.Duration(n),
+		Cents{Units: n, Exp: int32(n)},
+		Rate{},
+		[]string{s, ""},
+		[2][]Item{{{Name: s}}, nil},
+		map[string]int{s: int(n)},
+		map[int64][]*Item{n: {{Price: int(n)}, nil}},
+		List{{}, nil},
+	}
+}
+
+// FuzzValidateTag checks that no tag can cause panic for value of any kind.
+// Struct types are not built with reflect.StructOf for every tag, because
+// created types are never freed
+func FuzzValidateTag(f *testing.F) {
+	for _, tag := range fuzzTags {
+		f.Add(tag, "abc", int64(1), 1.5)
+	}
+
+	f.Fuzz(func(t *testing.T, tag, s string, n int64, x float64) {
+		values := fuzzValues(s, n, x)
+		for _, value := range values {
+			rules := parse.ValidationRules(tag)
+			rv := reflect.ValueOf(value)
+
+			var invalid []parse.Rule
+			if vp := buildValuePlan(rv.Type(), rules, make(map[reflect.Type]*structPlan), &invalid); vp != nil {
+				vs := &validation{ctx: context.Background()}
+				_ = vs.validateValue(rv, vp, "")
+			}
+
+			for _, rule := range rules {
+				if !parse.IsCrossField(rule.Op) {
+					continue
+				}
+				for _, other := range values {
+					_ = check.ValidCrossField(rv, reflect.ValueOf(other), rule)
+				}
+			}
+		}
+	})
+}
+
+// FuzzValidate checks that no values of structs with tags can cause panic
+func FuzzValidate(f *testing.F) {
+	f.Add("abc", int64(1), 1.5)
+	f.Add("", int64(-1), -0.5)
+
+	f.Fuzz(func(t *testing.T, s string, n int64, x float64) {
+		price := x
+		values := []any{
+			CrossFields{
+				DateTo:          time.Unix(n, 0),
+				Password:        s,
+				PasswordConfirm: s + s,
+				Published:       n%2 == 0,
+				Reason:          s,
+				MinPrice:        int(n),
+				MaxPrice:        &price,
+				Amount:          s,
+			},
+			Warehouse{
+				Codes:   []int{int(n)},
+				Stock:   map[string]int{s: int(n)},
+				Items:   []*Item{{Name: s, Price: int(n)}, nil},
+				ByID:    map[int]Item{int(n): {Name: s}},
+				Aliases: map[string]string{s: s},
+			},
+			Order{ID: int(n), Tags: []string{s}, Items: []Item{{Name: s, Price: int(n)}}},
+		}
+		for _, v := range values {
+			_ = Validate(v)
+			_ = Validate(&v)
+		}
+	})
+}
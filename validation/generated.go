@@ -0,0 +1,36 @@
+package go_course_validation
+
+import (
+	"reflect"
+	"sync"
+)
+
+// generated contains struct types which Validate method is generated by
+// validategen
+var generated sync.Map
+
+// RegisterGenerated is called by code generated by validategen for every
+// struct type ptr points to. Generated Validate method checks the same tags,
+// so Validate and ValidateCtx don't call it as Validatable. ValidateContext
+// method of such type is still called
+func RegisterGenerated(ptr any) {
+	generated.Store(reflect.TypeOf(ptr).Elem(), struct{}{})
+
+	// plans of the type might have been built with the hook
+	resetPlans()
+}
+
+func isGenerated(t reflect.Type) bool {
+	_, ok := generated.Load(t)
+	return ok
+}
+
+// PrefixErrors is used by code generated by validategen to convert error
+// returned by Validate or ValidateContext method of nested struct with path
+// to ValidationErrors, the same way as errors of hooks are converted
+func PrefixErrors(path string, err error) ValidationErrors {
+	if err == nil {
+		return nil
+	}
+	return hookErrors(err, path)
+}
@@ -0,0 +1,111 @@
+package go_course_validation
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/papey08/golang-fintech/validation/check"
+
+	"github.com/pkg/errors"
+)
+
+// Validatable is implemented by types which check their own invariants in
+// addition to validate tags. Validate is called after tags of the struct are
+// checked, it must not call package-level Validate for the same value
+type Validatable interface {
+	Validate() error
+}
+
+// ContextValidatable is the same as Validatable but receives context of the
+// validation. If type implements both interfaces only ValidateContext is
+// called
+type ContextValidatable interface {
+	ValidateContext(ctx context.Context) error
+}
+
+var (
+	validatableType        = reflect.TypeOf((*Validatable)(nil)).Elem()
+	contextValidatableType = reflect.TypeOf((*ContextValidatable)(nil)).Elem()
+)
+
+// hookKind describes how struct implements Validatable or ContextValidatable
+type hookKind int
+
+const (
+	noHook      hookKind = iota
+	valueHook            // methods with value receiver
+	pointerHook          // methods with pointer receiver
+)
+
+func hookOf(t reflect.Type) hookKind {
+	if isGenerated(t) {
+		// generated Validate method repeats checks of tags
+		switch {
+		case t.Implements(contextValidatableType):
+			return valueHook
+		case reflect.PointerTo(t).Implements(contextValidatableType):
+			return pointerHook
+		default:
+			return noHook
+		}
+	}
+
+	switch {
+	case t.Implements(contextValidatableType) || t.Implements(validatableType):
+		return valueHook
+	case reflect.PointerTo(t).Implements(contextValidatableType) || reflect.PointerTo(t).Implements(validatableType):
+		return pointerHook
+	default:
+		return noHook
+	}
+}
+
+// callHook calls Validate or ValidateContext method of struct v. Methods with
+// pointer receiver get a copy of v if v is not addressable
+func (s *validation) callHook(v reflect.Value, kind hookKind) error {
+	var x any
+	if kind == valueHook {
+		x = v.Interface()
+	} else if v.CanAddr() {
+		x = v.Addr().Interface()
+	} else {
+		p := reflect.New(v.Type())
+		p.Elem().Set(v)
+		x = p.Interface()
+	}
+
+	if cv, ok := x.(ContextValidatable); ok {
+		return cv.ValidateContext(s.ctx)
+	}
+	return x.(Validatable).Validate()
+}
+
+// hookErrors converts error returned by Validate method of struct with path
+// to ValidationErrors. Paths of returned ValidationError are considered
+// relative to the struct, missing Err is replaced with
+// check.ErrInvalidFieldValue
+func hookErrors(err error, path string) ValidationErrors {
+	var validationErrors ValidationErrors
+	if errors.As(err, &validationErrors) {
+		res := make(ValidationErrors, len(validationErrors))
+		for i, ve := range validationErrors {
+			res[i] = hookError(ve, path)
+		}
+		return res
+	}
+
+	var ve ValidationError
+	if errors.As(err, &ve) {
+		return ValidationErrors{hookError(ve, path)}
+	}
+
+	return ValidationErrors{{Field: path, Err: err}}
+}
+
+func hookError(ve ValidationError, path string) ValidationError {
+	ve.Field = joinPath(path, ve.Field)
+	if ve.Err == nil {
+		ve.Err = check.ErrInvalidFieldValue
+	}
+	return ve
+}
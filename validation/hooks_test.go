@@ -0,0 +1,135 @@
+package go_course_validation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/papey08/golang-fintech/validation/check"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errDiscount = errors.New("discount is greater than price")
+
+type Position struct {
+	Price    int `validate:"min:0"`
+	Discount int `validate:"min:0"`
+}
+
+func (p Position) Validate() error {
+	if p.Discount > p.Price {
+		return errDiscount
+	}
+	return nil
+}
+
+type Cart struct {
+	Owner     string `validate:"min:1"`
+	Positions []Position
+	Total     int
+}
+
+func (c *Cart) Validate() error {
+	sum := 0
+	for _, p := range c.Positions {
+		sum += p.Price - p.Discount
+	}
+	if sum != c.Total {
+		return ValidationError{Field: "Total", Rule: "sum", Value: c.Total, Err: check.ErrInvalidFieldValue}
+	}
+	return nil
+}
+
+type Session struct {
+	User string
+}
+
+func (s Session) ValidateContext(ctx context.Context) error {
+	if ctx == nil {
+		return errors.New("no context")
+	}
+	return ValidationErrors{{Field: "User", Rule: "exists", Err: check.ErrInvalidFieldValue}}
+}
+
+// Validate is never called because ValidateContext is preferred
+func (s Session) Validate() error {
+	return errors.New("unexpected call")
+}
+
+type Checkout struct {
+	Cart    Cart
+	Session Session
+}
+
+func TestValidatable(t *testing.T) {
+	cart := Cart{
+		Owner: "papey08",
+		Positions: []Position{
+			{Price: 100, Discount: 10},
+			{Price: 50, Discount: 0},
+		},
+		Total: 140,
+	}
+	assert.NoError(t, Validate(cart))
+
+	cart.Positions[1].Discount = 60
+	cart.Total = 80
+	err := Validate(cart)
+	var ve ValidationErrors
+	assert.True(t, errors.As(err, &ve))
+	assert.Equal(t, ValidationErrors{
+		{Field: "Positions[1]", Err: errDiscount},
+	}, ve)
+
+	cart.Owner = ""
+	cart.Total = 0
+	err = Validate(Checkout{Cart: cart})
+	assert.True(t, errors.As(err, &ve))
+	assert.Equal(t, ValidationErrors{
+		{Field: "Cart.Owner", Rule: "min", Args: []string{"1"}, Value: "", Err: check.ErrInvalidFieldValue},
+		{Field: "Cart.Positions[1]", Err: errDiscount},
+		{Field: "Cart.Total", Rule: "sum", Value: 0, Err: check.ErrInvalidFieldValue},
+		{Field: "Session.User", Rule: "exists", Err: check.ErrInvalidFieldValue},
+	}, ve)
+	assert.ErrorIs(t, err, errDiscount)
+
+	v, _ := Compile[Position]()
+	assert.ErrorIs(t, v.Validate(Position{Price: 1, Discount: 2}), errDiscount)
+}
+
+type Coupon struct {
+	Code string
+}
+
+// Validate returns errors without Err as user code may do
+func (c Coupon) Validate() error {
+	if c.Code == "" {
+		return ValidationError{Field: "Code"}
+	}
+	return ValidationErrors{{Field: "Code", Rule: "known"}}
+}
+
+func TestValidatableWithoutErr(t *testing.T) {
+	for _, coupon := range []Coupon{{}, {Code: "SALE"}} {
+		err := Validate(struct{ Coupon Coupon }{coupon})
+		var ve ValidationErrors
+		assert.True(t, errors.As(err, &ve))
+		assert.Len(t, ve, 1)
+		assert.Equal(t, "Coupon.Code", ve[0].Field)
+		assert.ErrorIs(t, err, check.ErrInvalidFieldValue)
+		assert.NotPanics(t, func() {
+			assert.NotEmpty(t, err.Error())
+			_, jsonErr := json.Marshal(ve)
+			assert.NoError(t, jsonErr)
+			assert.NotEmpty(t, ve.Localize("ru")[0].Message)
+		})
+	}
+
+	// errors built by hand are safe too
+	ve := ValidationError{Field: "Code"}
+	assert.Equal(t, "Code: "+check.ErrInvalidFieldValue.Error(), ve.Error())
+	_, err := json.Marshal(ve)
+	assert.NoError(t, err)
+}
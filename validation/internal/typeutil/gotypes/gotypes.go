@@ -0,0 +1,39 @@
+// Package gotypes contains helpers for go/types shared by validategen and
+// validatelint
+package gotypes
+
+import "go/types"
+
+// Deref returns type t points to, types pointing to themselves like
+// type P *P are returned as they are
+func Deref(t types.Type) types.Type {
+	for seen := make(map[types.Type]bool); !seen[t]; {
+		p, ok := t.Underlying().(*types.Pointer)
+		if !ok {
+			return t
+		}
+		seen[t] = true
+		t = p.Elem()
+	}
+	return t
+}
+
+// IsDecimal reports whether method set of t implements check.Decimal
+func IsDecimal(t types.Type) bool {
+	ms := types.NewMethodSet(t)
+	coef, exp := ms.Lookup(nil, "Coefficient"), ms.Lookup(nil, "Exponent")
+	if coef == nil || exp == nil {
+		return false
+	}
+	return resultOf(coef) == "*math/big.Int" && resultOf(exp) == "int32"
+}
+
+// resultOf returns the only result type of method without params or empty
+// string
+func resultOf(sel *types.Selection) string {
+	sig := sel.Type().(*types.Signature)
+	if sig.Params().Len() != 0 || sig.Results().Len() != 1 {
+		return ""
+	}
+	return sig.Results().At(0).Type().String()
+}
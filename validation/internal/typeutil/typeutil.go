@@ -0,0 +1,15 @@
+// Package typeutil contains helpers for reflect types shared by validation
+// and schema. Helpers for go/types live in typeutil/gotypes, so programs
+// using validation don't link go/types
+package typeutil
+
+import "reflect"
+
+// Deref returns type t points to, types pointing to themselves like
+// type P *P are returned as they are
+func Deref(t reflect.Type) reflect.Type {
+	for seen := make(map[reflect.Type]bool); t.Kind() == reflect.Pointer && !seen[t]; t = t.Elem() {
+		seen[t] = true
+	}
+	return t
+}
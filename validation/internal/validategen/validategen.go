@@ -0,0 +1,744 @@
+// Package validategen generates reflection-free Validate methods of structs
+// with validate tags, it is used by cmd/validategen
+package validategen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/papey08/golang-fintech/validation/internal/typeutil/gotypes"
+	"github.com/papey08/golang-fintech/validation/parse"
+
+	"github.com/pkg/errors"
+)
+
+// Header starts files written by validategen, such files are skipped when
+// package is loaded, so they can be regenerated
+const Header = "// Code generated by validategen. DO NOT EDIT."
+
+const (
+	validationPath = "github.com/papey08/golang-fintech/validation"
+	checkPath      = validationPath + "/check"
+	parsePath      = validationPath + "/parse"
+)
+
+var ErrNoPackage = errors.New("no Go package found")
+var ErrNoTypes = errors.New("no structs with validate tags found")
+var ErrInvalidType = errors.New("type is not a struct declared in the package")
+var ErrHasValidate = errors.New("type already has Validate method")
+
+// Generate returns source of file with Validate methods of struct types of
+// package in dir. If names is empty, methods are generated for every struct
+// which has validate tags or nested structs with them. Fields which can't be
+// checked without reflection, e.g. cross-field rules, registered rules, dive
+// and maps, make Validate of the struct call package-level Validate
+func Generate(dir string, names []string) ([]byte, error) {
+	pkg, err := load(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{pkg: pkg, selected: make(map[*types.Named]bool)}
+	if err = g.selectTypes(names); err != nil {
+		return nil, err
+	}
+	if len(g.order) == 0 {
+		return nil, ErrNoTypes
+	}
+	for _, n := range g.order {
+		g.genType(n)
+	}
+	return g.source()
+}
+
+// load parses and type-checks non-test files of package in dir except files
+// generated by validategen. Type errors are ignored, since code may call
+// methods which are not generated yet
+func load(dir string) (*types.Package, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		if ok, err := build.Default.MatchFile(dir, name); err != nil || !ok {
+			continue
+		}
+		src, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		if bytes.HasPrefix(src, []byte(Header)) {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), src, 0)
+		if err != nil {
+			return nil, err
+		}
+		if len(files) != 0 && f.Name.Name != files[0].Name.Name {
+			return nil, errors.Errorf("%s: packages %s and %s in the same directory", dir, files[0].Name.Name, f.Name.Name)
+		}
+		files = append(files, f)
+	}
+	if len(files) == 0 {
+		return nil, errors.Wrap(ErrNoPackage, dir)
+	}
+
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error:    func(error) {},
+	}
+	pkg, _ := conf.Check(files[0].Name.Name, fset, files, nil)
+	return pkg, nil
+}
+
+// generator holds state of generation of a single file
+type generator struct {
+	pkg      *types.Package
+	selected map[*types.Named]bool // types Validate is generated for
+	order    []*types.Named        // selected types in order of declaration
+
+	rules bytes.Buffer // declarations of parsed tags
+	body  bytes.Buffer // methods
+	inits bytes.Buffer // registration of types
+
+	imports map[string]bool
+	labels  int // counter of labels of loops in the current method
+}
+
+// selectTypes fills selected with types listed in names or with all structs
+// of the package which have something to check
+func (g *generator) selectTypes(names []string) error {
+	scope := g.pkg.Scope()
+	if len(names) == 0 {
+		names = scope.Names() // sorted
+		for _, name := range names {
+			n := g.namedStruct(scope.Lookup(name))
+			if n != nil && g.hasEffect(n) && !hasMethod(n, "Validate") {
+				g.selected[n] = true
+				g.order = append(g.order, n)
+			}
+		}
+		return nil
+	}
+
+	for _, name := range names {
+		n := g.namedStruct(scope.Lookup(name))
+		if n == nil {
+			return errors.Wrap(ErrInvalidType, name)
+		}
+		if hasMethod(n, "Validate") {
+			return errors.Wrap(ErrHasValidate, name)
+		}
+		if !g.selected[n] {
+			g.selected[n] = true
+			g.order = append(g.order, n)
+		}
+	}
+	return nil
+}
+
+// namedStruct returns named struct type declared by obj, generic types and
+// aliases are not supported
+func (g *generator) namedStruct(obj types.Object) *types.Named {
+	tn, ok := obj.(*types.TypeName)
+	if !ok || tn.IsAlias() {
+		return nil
+	}
+	n, ok := tn.Type().(*types.Named)
+	if !ok || n.TypeParams().Len() != 0 {
+		return nil
+	}
+	if _, ok = n.Underlying().(*types.Struct); !ok {
+		return nil
+	}
+	return n
+}
+
+// hasEffect reports whether package-level Validate does anything for value of
+// type t: some struct reachable from t has validate tags or Validate methods
+func (g *generator) hasEffect(t types.Type) bool {
+	return walkStructs(t, make(map[*types.Named]bool), func(n *types.Named, st *types.Struct) bool {
+		return hasTags(st) || (n != nil && hasHook(n))
+	})
+}
+
+// contentEffect is the same as hasEffect but for values inside t: fields of
+// struct t, elements of collection t
+func (g *generator) contentEffect(st *types.Struct) bool {
+	for i := 0; i < st.NumFields(); i++ {
+		if f := st.Field(i); f.Exported() && g.hasEffect(f.Type()) {
+			return true
+		}
+	}
+	return false
+}
+
+// walkStructs calls f for structs reachable from t the same way as Validate
+// traverses values: through pointers, collections and exported fields.
+// Returns true as soon as f does
+func walkStructs(t types.Type, seen map[*types.Named]bool, f func(n *types.Named, st *types.Struct) bool) bool {
+	n, _ := t.(*types.Named)
+	if n != nil {
+		if seen[n] {
+			return false
+		}
+		seen[n] = true
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Pointer:
+		return walkStructs(u.Elem(), seen, f)
+	case *types.Slice:
+		return walkStructs(u.Elem(), seen, f)
+	case *types.Array:
+		return walkStructs(u.Elem(), seen, f)
+	case *types.Map:
+		return walkStructs(u.Elem(), seen, f)
+	case *types.Struct:
+		if f(n, u) {
+			return true
+		}
+		for i := 0; i < u.NumFields(); i++ {
+			if field := u.Field(i); field.Exported() && walkStructs(field.Type(), seen, f) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isRecursive reports whether struct n can be reached from its own fields
+func isRecursive(n *types.Named) bool {
+	st := n.Underlying().(*types.Struct)
+	seen := make(map[*types.Named]bool)
+	for i := 0; i < st.NumFields(); i++ {
+		if !st.Field(i).Exported() {
+			continue
+		}
+		found := walkStructs(st.Field(i).Type(), seen, func(m *types.Named, _ *types.Struct) bool {
+			return m == n
+		})
+		if found {
+			return true
+		}
+	}
+	return false
+}
+
+func hasTags(st *types.Struct) bool {
+	for i := 0; i < st.NumFields(); i++ {
+		if _, ok := reflect.StructTag(st.Tag(i)).Lookup("validate"); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func hasHook(n *types.Named) bool {
+	return hasMethod(n, "Validate") || hasMethod(n, "ValidateContext")
+}
+
+// hasMethod reports whether method with name is in method set of *n
+func hasMethod(n *types.Named, name string) bool {
+	return types.NewMethodSet(types.NewPointer(n)).Lookup(n.Obj().Pkg(), name) != nil
+}
+
+// fallbackReason returns why Validate of struct n can't be generated without
+// reflection or empty string if it can
+func (g *generator) fallbackReason(n *types.Named) string {
+	if isRecursive(n) {
+		return "type is recursive"
+	}
+
+	st := n.Underlying().(*types.Struct)
+	for i := 0; i < st.NumFields(); i++ {
+		field := st.Field(i)
+		if !field.Exported() {
+			continue
+		}
+		if tag, ok := ungroupedTag(reflect.StructTag(st.Tag(i))); ok {
+			checked := false
+			for _, rule := range parse.ValidationRules(tag) {
+				if reason := ruleFallback(rule); reason != "" {
+					return fmt.Sprintf("field %s has %s", field.Name(), reason)
+				}
+				if parse.IsPresence(rule.Op) && !presenceSupported(field.Type(), rule.Op) {
+					return fmt.Sprintf("emptiness of field %s can't be checked by generated code", field.Name())
+				}
+				checked = checked || rule.Op != parse.Wrong && !parse.IsPresence(rule.Op)
+			}
+			if checked && !ruleTypeSupported(field.Type()) {
+				return fmt.Sprintf("rules of field %s are applied to map or interface", field.Name())
+			}
+		}
+		if !g.contentSupported(field.Type()) {
+			return fmt.Sprintf("field %s contains structs which can't be checked by generated code", field.Name())
+		}
+	}
+	return ""
+}
+
+// ungroupedTag returns validate tag of field unless the field has groups.
+// Rules of groups are checked only when ValidateWith activates them, so
+// generated Validate skips them as Validate does
+func ungroupedTag(tag reflect.StructTag) (string, bool) {
+	if len(parse.Groups(tag.Get("groups"))) != 0 {
+		return "", false
+	}
+	return tag.Lookup("validate")
+}
+
+// ruleFallback returns why rule can't be checked without reflection or empty
+// string if it can
+func ruleFallback(rule parse.Rule) string {
+	switch {
+	case rule.Op == parse.Dive || rule.Op == parse.Keys || rule.Op == parse.EndKeys:
+		return "rule " + rule.Name
+	case parse.IsCrossField(rule.Op):
+		return "cross-field rule " + rule.Name
+	case rule.Op == parse.Wrong && !parse.IsBuiltin(rule.Name):
+		return "rule " + strconv.Quote(rule.Name) + " which is not built-in"
+	}
+	return ""
+}
+
+// ruleTypeSupported reports whether type of values rules are applied to is
+// known statically
+func ruleTypeSupported(t types.Type) bool {
+	switch u := t.Underlying().(type) {
+	case *types.Pointer:
+		return ruleTypeSupported(u.Elem())
+	case *types.Slice:
+		return ruleTypeSupported(u.Elem())
+	case *types.Array:
+		return ruleTypeSupported(u.Elem())
+	case *types.Map, *types.Interface:
+		return false
+	}
+	return true
+}
+
+// presenceSupported reports whether presence rule op can be checked for value
+// of type t by generated code
+func presenceSupported(t types.Type, op parse.ValidationOperation) bool {
+	switch u := t.Underlying().(type) {
+	case *types.Pointer:
+		return op != parse.NonZero || presenceSupported(u.Elem(), op)
+	case *types.Interface:
+		return op != parse.NonZero
+	}
+	_, ok := emptyCond("x", t)
+	return ok
+}
+
+// emptyCond returns condition which is true if value expr of type t is empty
+// as check.IsEmpty defines it. Zero structs and arrays other than time.Time
+// can't be detected without reflection
+func emptyCond(expr string, t types.Type) (string, bool) {
+	if n, ok := t.(*types.Named); ok && n.Obj().Pkg() != nil && n.Obj().Pkg().Path() == "time" && n.Obj().Name() == "Time" {
+		return operand(expr) + ".IsZero()", true
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Pointer, *types.Interface, *types.Signature:
+		return expr + " == nil", true
+	case *types.Slice, *types.Map, *types.Chan:
+		return "len(" + expr + ") == 0", true
+	case *types.Basic:
+		switch info := u.Info(); {
+		case info&types.IsString != 0:
+			return "len(" + expr + ") == 0", true
+		case info&types.IsBoolean != 0:
+			return "!" + operand(expr), true
+		case info&types.IsNumeric != 0:
+			return expr + " == 0", true
+		case u.Kind() == types.UnsafePointer:
+			return expr + " == nil", true
+		}
+	}
+	return "", false
+}
+
+// contentSupported reports whether structs inside value of type t can be
+// checked by generated code: their Validate is generated too or they have
+// only Validate method written by hand
+func (g *generator) contentSupported(t types.Type) bool {
+	if !g.hasEffect(t) {
+		return true
+	}
+	if n, ok := t.(*types.Named); ok {
+		if st, ok := n.Underlying().(*types.Struct); ok {
+			return g.selected[n] || (hasHook(n) && !hasTags(st) && !g.contentEffect(st))
+		}
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Pointer:
+		return g.contentSupported(u.Elem())
+	case *types.Slice:
+		return g.contentSupported(u.Elem())
+	case *types.Array:
+		return g.contentSupported(u.Elem())
+	}
+	return false // maps and unnamed structs
+}
+
+func (g *generator) use(path string) {
+	if g.imports == nil {
+		g.imports = make(map[string]bool)
+	}
+	g.imports[path] = true
+}
+
+// genType writes Validate method of struct n
+func (g *generator) genType(n *types.Named) {
+	name := n.Obj().Name()
+	g.use(validationPath)
+	fmt.Fprintf(&g.inits, "validation.RegisterGenerated((*%s)(nil))\n", name)
+
+	if reason := g.fallbackReason(n); reason != "" {
+		fmt.Fprintf(&g.body, "\n// Validate checks fields of %s according to their validate tags. It\n", name)
+		fmt.Fprintf(&g.body, "// calls validation.Validate since %s\n", reason)
+		fmt.Fprintf(&g.body, "func (x *%s) Validate() error {\nreturn validation.Validate(x)\n}\n", name)
+		return
+	}
+
+	st := n.Underlying().(*types.Struct)
+	rulesVar := "validateRules" + name
+	var tags []string
+
+	g.labels = 0
+	w := &g.body
+	fmt.Fprintf(w, "\n// Validate checks fields of %s according to their validate tags\n", name)
+	fmt.Fprintf(w, "func (x *%s) Validate() error {\n", name)
+	fmt.Fprintf(w, "if x == nil {\nreturn validation.ErrNilValue\n}\n")
+	fmt.Fprintf(w, "var errs validation.ValidationErrors\n")
+
+	for i := 0; i < st.NumFields(); i++ {
+		field := st.Field(i)
+		tag := reflect.StructTag(st.Tag(i))
+		validateTag, tagged := ungroupedTag(tag)
+		f := errorFields{path: strconv.Quote(field.Name()), label: tag.Get("label")}
+
+		if !field.Exported() {
+			if tagged {
+				fmt.Fprintf(w, "errs = append(errs, %s)\n", f.literal("validation.ErrValidateForUnexportedFields"))
+			}
+			continue
+		}
+
+		expr := "x." + field.Name()
+		content := g.hasEffect(field.Type())
+		if tagged || content {
+			fmt.Fprintf(w, "// %s\n", field.Name())
+		}
+		// omitempty wraps the other checks of the field in condition
+		open, end := "", ""
+		if tagged {
+			g.use(parsePath)
+			rules := parse.ValidationRules(validateTag)
+			for _, rule := range rules {
+				if rule.Op == parse.OmitEmpty {
+					cond, _ := emptyCond(expr, field.Type())
+					open, end = "if !("+cond+") {\n", "}\n"
+				}
+			}
+			for j, rule := range rules {
+				f.rule = fmt.Sprintf("%s[%d][%d]", rulesVar, len(tags), j)
+				switch rule.Op {
+				case parse.Wrong:
+					fmt.Fprintf(w, "errs = append(errs, %s)\n", f.literal("validation.ErrInvalidValidatorSyntax"))
+				case parse.LenMode, parse.TimeZone, parse.OmitEmpty:
+					// lenmode, tz and omitempty only modify other rules of the tag
+				case parse.Required, parse.NonZero:
+					fmt.Fprint(w, open)
+					g.genPresence(expr, field.Type(), f, rule.Op, 1)
+					fmt.Fprint(w, end)
+				default:
+					fmt.Fprint(w, open)
+					g.genRule(expr, field.Type(), f, "", 1)
+					fmt.Fprint(w, end)
+				}
+			}
+			tags = append(tags, fmt.Sprintf("parse.ValidationRules(%s), // %s", strconv.Quote(validateTag), field.Name()))
+		}
+		if content {
+			fmt.Fprint(w, open)
+			g.genContent(expr, field.Type(), f.path, 1)
+			fmt.Fprint(w, end)
+		}
+	}
+
+	if hasMethod(n, "ValidateContext") {
+		g.use("context")
+		fmt.Fprintf(w, "errs = append(errs, validation.PrefixErrors(\"\", x.ValidateContext(context.Background()))...)\n")
+	}
+	fmt.Fprintf(w, "if len(errs) != 0 {\nreturn errs\n}\nreturn nil\n}\n")
+
+	if len(tags) != 0 {
+		fmt.Fprintf(&g.rules, "\nvar %s = [...][]parse.Rule{\n%s\n}\n", rulesVar, strings.Join(tags, "\n"))
+	}
+}
+
+// errorFields describes ValidationError of a rule in generated code
+type errorFields struct {
+	path  string // expression of path to the value
+	label string
+	rule  string // expression of parse.Rule, empty for unexported fields
+}
+
+// literal returns expression of ValidationError with err
+func (f errorFields) literal(err string) string {
+	return f.valueLiteral("", err)
+}
+
+// valueLiteral returns expression of ValidationError with value and err
+func (f errorFields) valueLiteral(value, err string) string {
+	var res strings.Builder
+	res.WriteString("validation.ValidationError{Field: " + f.path)
+	if f.label != "" {
+		res.WriteString(", Label: " + strconv.Quote(f.label))
+	}
+	if f.rule != "" {
+		res.WriteString(", Rule: " + f.rule + ".Name, Args: " + f.rule + ".Params")
+	}
+	if value != "" {
+		res.WriteString(", Value: " + value)
+	}
+	res.WriteString(", Err: " + err + "}")
+	return res.String()
+}
+
+// genRule writes check of value expr of type t against rule the same way as
+// Validate does: nil pointers are skipped and only the first failed element
+// of slice or array is reported. Loop is the label of the outer loop
+func (g *generator) genRule(expr string, t types.Type, f errorFields, loop string, depth int) {
+	w := &g.body
+	switch u := t.Underlying().(type) {
+	case *types.Pointer:
+		p := fmt.Sprintf("p%d", depth)
+		fmt.Fprintf(w, "if %s := %s; %s != nil {\n", p, expr, p)
+		g.genRule("*"+p, u.Elem(), f, loop, depth+1)
+		fmt.Fprintf(w, "}\n")
+		return
+
+	case *types.Slice, *types.Array:
+		elem := u.(interface{ Elem() types.Type }).Elem()
+		if loop == "" {
+			g.labels++
+			loop = fmt.Sprintf("rule%d", g.labels)
+			fmt.Fprintf(w, "%s:\n", loop)
+		}
+		i := fmt.Sprintf("i%d", depth)
+		fmt.Fprintf(w, "for %s := range %s {\n", i, expr)
+		elemPath := f
+		elemPath.path = g.indexPath(f.path, i)
+		g.genRule(operand(expr)+"["+i+"]", elem, elemPath, loop, depth+1)
+		fmt.Fprintf(w, "}\n")
+		return
+	}
+
+	var brk string
+	if loop != "" {
+		brk = "break " + loop + "\n"
+	}
+
+	if call := g.checkCall(expr, t, f.rule); call != "" {
+		fmt.Fprintf(w, "if err := %s; err != nil {\nerrs = append(errs, %s)\n%s}\n", call, f.valueLiteral(expr, "err"), brk)
+		return
+	}
+	// rules can't be applied to the other kinds
+	g.use(checkPath)
+	fmt.Fprintf(w, "errs = append(errs, %s)\n%s", f.valueLiteral(expr, "check.ErrInvalidFieldType"), brk)
+}
+
+// genPresence writes check of value expr of type t against required or
+// nonzero rule op. Required fails only for nil pointer, while nonzero
+// dereferences it and fails for pointer to empty value too
+func (g *generator) genPresence(expr string, t types.Type, f errorFields, op parse.ValidationOperation, depth int) {
+	w := &g.body
+	g.use(checkPath)
+	switch u := t.Underlying().(type) {
+	case *types.Pointer:
+		if op == parse.NonZero {
+			p := fmt.Sprintf("p%d", depth)
+			fmt.Fprintf(w, "if %s := %s; %s == nil {\nerrs = append(errs, %s)\n} else {\n", p, expr, p, f.literal("check.ErrInvalidFieldValue"))
+			g.genPresence("*"+p, u.Elem(), f, op, depth+1)
+			fmt.Fprintf(w, "}\n")
+			return
+		}
+		fmt.Fprintf(w, "if %s == nil {\nerrs = append(errs, %s)\n}\n", expr, f.literal("check.ErrInvalidFieldValue"))
+		return
+
+	case *types.Interface:
+		fmt.Fprintf(w, "if %s == nil {\nerrs = append(errs, %s)\n}\n", expr, f.literal("check.ErrInvalidFieldValue"))
+		return
+	}
+
+	cond, _ := emptyCond(expr, t)
+	fmt.Fprintf(w, "if %s {\nerrs = append(errs, %s)\n}\n", cond, f.valueLiteral(expr, "check.ErrInvalidFieldValue"))
+}
+
+// checkCall returns call of function of check package for value expr of basic
+// type, time.Time, time.Duration or check.Decimal t or empty string if there
+// is no such function
+func (g *generator) checkCall(expr string, t types.Type, rule string) string {
+	if n, ok := t.(*types.Named); ok && n.Obj().Pkg() != nil && n.Obj().Pkg().Path() == "time" {
+		switch n.Obj().Name() {
+		case "Time":
+			g.use(checkPath)
+			return fmt.Sprintf("check.ValidTime(%s, %s)", expr, rule)
+		case "Duration":
+			g.use(checkPath)
+			return fmt.Sprintf("check.ValidDuration(%s, %s)", expr, rule)
+		}
+	}
+
+	if gotypes.IsDecimal(t) {
+		g.use(checkPath)
+		return fmt.Sprintf("check.ValidDecimal(%s, %s)", expr, rule)
+	}
+	if gotypes.IsDecimal(types.NewPointer(t)) {
+		g.use(checkPath)
+		return fmt.Sprintf("check.ValidDecimal(&%s, %s)", expr, rule)
+	}
+
+	basic, ok := t.Underlying().(*types.Basic)
+	if !ok {
+		return ""
+	}
+
+	var call string
+	switch basic.Kind() {
+	case types.String:
+		call = fmt.Sprintf("check.ValidString(string(%s), %s)", expr, rule)
+	case types.Int:
+		g.use("strconv")
+		call = fmt.Sprintf("check.ValidInt(int64(%s), strconv.IntSize, %s)", expr, rule)
+	case types.Int8, types.Int16, types.Int32, types.Int64:
+		call = fmt.Sprintf("check.ValidInt(int64(%s), %d, %s)", expr, bitSize(basic), rule)
+	case types.Uint, types.Uintptr:
+		g.use("strconv")
+		call = fmt.Sprintf("check.ValidUint(uint64(%s), strconv.IntSize, %s)", expr, rule)
+	case types.Uint8, types.Uint16, types.Uint32, types.Uint64:
+		call = fmt.Sprintf("check.ValidUint(uint64(%s), %d, %s)", expr, bitSize(basic), rule)
+	case types.Float32, types.Float64:
+		call = fmt.Sprintf("check.ValidFloat(float64(%s), %d, %s)", expr, bitSize(basic), rule)
+	default:
+		return ""
+	}
+	g.use(checkPath)
+	return call
+}
+
+func bitSize(basic *types.Basic) int {
+	switch basic.Kind() {
+	case types.Int8, types.Uint8:
+		return 8
+	case types.Int16, types.Uint16:
+		return 16
+	case types.Int32, types.Uint32, types.Float32:
+		return 32
+	default:
+		return 64
+	}
+}
+
+// genContent writes checks of structs inside value expr of type t with path:
+// calls of their generated or hand-written Validate methods
+func (g *generator) genContent(expr string, t types.Type, path string, depth int) {
+	if !g.hasEffect(t) {
+		return
+	}
+
+	w := &g.body
+	if n, ok := t.(*types.Named); ok {
+		if _, ok := n.Underlying().(*types.Struct); ok {
+			call := operand(expr) + ".Validate()"
+			if !g.selected[n] && hasMethod(n, "ValidateContext") {
+				g.use("context")
+				call = operand(expr) + ".ValidateContext(context.Background())"
+			}
+			fmt.Fprintf(w, "errs = append(errs, validation.PrefixErrors(%s, %s)...)\n", path, call)
+			return
+		}
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Pointer:
+		p := fmt.Sprintf("p%d", depth)
+		fmt.Fprintf(w, "if %s := %s; %s != nil {\n", p, expr, p)
+		g.genContent("*"+p, u.Elem(), path, depth+1)
+		fmt.Fprintf(w, "}\n")
+
+	case *types.Slice, *types.Array:
+		elem := u.(interface{ Elem() types.Type }).Elem()
+		i := fmt.Sprintf("i%d", depth)
+		fmt.Fprintf(w, "for %s := range %s {\n", i, expr)
+		g.genContent(operand(expr)+"["+i+"]", elem, g.indexPath(path, i), depth+1)
+		fmt.Fprintf(w, "}\n")
+	}
+}
+
+// operand puts dereference expr in parentheses, so it can be indexed or
+// have its method called
+func operand(expr string) string {
+	if strings.HasPrefix(expr, "*") {
+		return "(" + expr + ")"
+	}
+	return expr
+}
+
+// indexPath returns expression of path to element i of collection with path
+func (g *generator) indexPath(path, i string) string {
+	g.use("strconv")
+	if strings.HasSuffix(path, `"`) {
+		return path[:len(path)-1] + `[" + strconv.Itoa(` + i + `) + "]"`
+	}
+	return path + ` + "[" + strconv.Itoa(` + i + `) + "]"`
+}
+
+// source assembles and formats the generated file
+func (g *generator) source() ([]byte, error) {
+	var res bytes.Buffer
+	fmt.Fprintf(&res, "%s\n\npackage %s\n\nimport (\n", Header, g.pkg.Name())
+	for _, path := range []string{"context", "strconv"} {
+		if g.imports[path] {
+			fmt.Fprintf(&res, "%q\n", path)
+		}
+	}
+	fmt.Fprintf(&res, "\nvalidation %q\n", validationPath)
+	for _, path := range []string{checkPath, parsePath} {
+		if g.imports[path] {
+			fmt.Fprintf(&res, "%q\n", path)
+		}
+	}
+	fmt.Fprintf(&res, ")\n\nfunc init() {\n%s}\n", g.inits.String())
+	res.Write(g.rules.Bytes())
+	res.Write(g.body.Bytes())
+
+	src, err := format.Source(res.Bytes())
+	if err != nil {
+		return nil, errors.Wrap(err, "generated code is invalid")
+	}
+	return src, nil
+}
@@ -0,0 +1,24 @@
+// Command validatelint reports invalid validate tags: unknown rules,
+// malformed args, rules which can't be applied to type of the field and rules
+// which can never be satisfied. Usage:
+//
+//	go run github.com/papey08/golang-fintech/validation/lint/cmd/validatelint ./...
+//
+// or as a vet tool:
+//
+//	go build -o validatelint github.com/papey08/golang-fintech/validation/lint/cmd/validatelint
+//	go vet -vettool=$(pwd)/validatelint ./...
+//
+// Names of rules registered by RegisterValidation are passed with
+// -custom=name1,name2
+package main
+
+import (
+	"github.com/papey08/golang-fintech/validation/lint"
+
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(lint.Analyzer)
+}
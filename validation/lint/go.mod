@@ -0,0 +1,17 @@
+module github.com/papey08/golang-fintech/validation/lint
+
+go 1.25.0
+
+require (
+	github.com/papey08/golang-fintech/validation v0.0.0
+	github.com/pkg/errors v0.9.1
+	golang.org/x/tools v0.47.0
+)
+
+require (
+	github.com/rivo/uniseg v0.4.7 // indirect
+	golang.org/x/mod v0.37.0 // indirect
+	golang.org/x/sync v0.21.0 // indirect
+)
+
+replace github.com/papey08/golang-fintech/validation => ../
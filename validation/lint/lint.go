@@ -0,0 +1,397 @@
+// Package lint contains analyzer of validate tags, which reports at vet time
+// the mistakes Validate reports only at runtime: unknown rules, malformed
+// args, rules which can't be applied to type of the field and rules which
+// can never be satisfied
+package lint
+
+import (
+	"fmt"
+	"go/types"
+	"math/big"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/papey08/golang-fintech/validation/check"
+	"github.com/papey08/golang-fintech/validation/internal/typeutil/gotypes"
+	"github.com/papey08/golang-fintech/validation/parse"
+
+	"github.com/pkg/errors"
+	"golang.org/x/tools/go/analysis"
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name: "validatelint",
+	Doc: "check validate tags of struct fields\n\n" +
+		"Tags are parsed the same way Validate parses them, rules are checked " +
+		"against types of fields by the same functions Validate uses.",
+	Run: run,
+}
+
+// customRules contains names of rules registered by RegisterValidation in
+// the checked packages, they can't be found statically
+var customRules string
+
+func init() {
+	Analyzer.Flags.StringVar(&customRules, "custom", "", "comma-separated names of rules registered by RegisterValidation")
+}
+
+var registerOnce sync.Once
+var errRegister error
+
+// registerCustom adds rules from -custom flag to the grammar of tags
+func registerCustom() error {
+	registerOnce.Do(func() {
+		for _, name := range strings.Split(customRules, ",") {
+			if name = strings.TrimSpace(name); name == "" {
+				continue
+			}
+			if _, err := parse.RegisterOperation(name); err != nil {
+				errRegister = errors.Wrapf(err, "rule %q from -custom", name)
+				return
+			}
+		}
+	})
+	return errRegister
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	if err := registerCustom(); err != nil {
+		return nil, err
+	}
+
+	// every struct type of the package is checked once, including anonymous
+	// structs and structs of generic types
+	seen := make(map[*types.Struct]bool)
+	for _, tv := range pass.TypesInfo.Types {
+		st, ok := tv.Type.(*types.Struct)
+		if !ok || seen[st] {
+			continue
+		}
+		seen[st] = true
+		for i := 0; i < st.NumFields(); i++ {
+			validateTag, ok := reflect.StructTag(st.Tag(i)).Lookup("validate")
+			if !ok {
+				continue
+			}
+			f := fieldCheck{pass: pass, owner: st, field: st.Field(i)}
+			f.check(validateTag)
+		}
+	}
+	return nil, nil
+}
+
+// fieldCheck reports problems of validate tag of field of struct owner
+type fieldCheck struct {
+	pass  *analysis.Pass
+	owner *types.Struct
+	field *types.Var
+}
+
+func (f *fieldCheck) report(format string, args ...any) {
+	f.pass.Reportf(f.field.Pos(), "validate tag of %s: %s", f.field.Name(), fmt.Sprintf(format, args...))
+}
+
+func (f *fieldCheck) check(validateTag string) {
+	if !f.field.Exported() {
+		f.report("unexported fields can't be validated")
+		return
+	}
+	f.checkValue(f.field.Type(), parse.ValidationRules(validateTag), true)
+}
+
+// checkValue checks rules applied to value of type t the same way plan of
+// Validate splits them: rules before dive are applied to the value itself,
+// the other ones to its keys and elements. Top is false for keys and elements
+func (f *fieldCheck) checkValue(t types.Type, rules []parse.Rule, top bool) {
+	self, keys, elems, dive, misplaced := parse.SplitDive(rules)
+	for _, rule := range misplaced {
+		f.report("rule %s is out of place", rule.Name)
+	}
+
+	t = gotypes.Deref(t)
+	_, isMap := t.Underlying().(*types.Map)
+	for _, rule := range self {
+		f.checkRule(t, rule, dive || isMap, top)
+	}
+	f.checkBounds(self)
+
+	if !dive {
+		return
+	}
+	switch u := t.Underlying().(type) {
+	case *types.Slice:
+		f.checkElems(keys, "slice")
+		f.checkValue(u.Elem(), elems, false)
+	case *types.Array:
+		f.checkElems(keys, "array")
+		f.checkValue(u.Elem(), elems, false)
+	case *types.Map:
+		if keys != nil {
+			f.checkValue(u.Key(), keys, false)
+		}
+		f.checkValue(u.Elem(), elems, false)
+	default:
+		if _, ok := t.Underlying().(*types.Interface); !ok {
+			f.report("dive is applied to %s, which is not a collection", f.typeString(t))
+		}
+	}
+}
+
+// checkElems reports rules for keys of collection which is not a map
+func (f *fieldCheck) checkElems(keys []parse.Rule, kind string) {
+	if keys != nil {
+		f.report("keys is applied to %s, which has no keys", kind)
+	}
+}
+
+// checkRule checks single rule applied to value of type t. Collection is
+// true if rule restricts number of elements instead of the elements
+func (f *fieldCheck) checkRule(t types.Type, rule parse.Rule, collection, top bool) {
+	switch {
+	case rule.Op == parse.Wrong:
+		f.report("%s", wrongReason(rule))
+
+	case parse.IsCrossField(rule.Op):
+		if !top {
+			f.report("cross-field rule %s can't be applied to keys and elements", rule.Name)
+			return
+		}
+		var ref parse.FieldRef
+		switch args := rule.Args.(type) {
+		case parse.FieldRef:
+			ref = args
+		case parse.Condition:
+			ref = args.Field
+		}
+		if !f.resolve(ref) {
+			f.report("rule %s refers to %s, which is not an exported field", ruleText(rule), ref)
+		}
+
+	case parse.IsPresence(rule.Op), parse.IsCustom(rule.Op),
+		rule.Op == parse.LenMode, rule.Op == parse.TimeZone:
+		// presence is checked for values of any type, types of values of
+		// custom rules are checked by their functions
+
+	default:
+		if reason := unsatisfiable(rule); reason != "" {
+			f.report("rule %s %s", ruleText(rule), reason)
+			return
+		}
+		target, ok := applicable(t, rule, collection)
+		switch {
+		case ok:
+		case collection:
+			f.report("rule %s can't be applied to %s as a whole, only to its elements after dive", ruleText(rule), f.typeString(t))
+		case target != t:
+			f.report("rule %s can't be applied to %s, elements of %s", ruleText(rule), f.typeString(target), f.typeString(t))
+		default:
+			f.report("rule %s can't be applied to %s", ruleText(rule), f.typeString(t))
+		}
+	}
+}
+
+// resolve reports whether ref is a path to exported field of the owner
+// struct, pointers to nested structs are followed as Validate does
+func (f *fieldCheck) resolve(ref parse.FieldRef) bool {
+	var t types.Type = f.owner
+	for _, name := range ref {
+		obj, _, _ := types.LookupFieldOrMethod(gotypes.Deref(t), false, f.field.Pkg(), name)
+		field, ok := obj.(*types.Var)
+		if !ok || !field.IsField() || !field.Exported() {
+			return false
+		}
+		t = field.Type()
+	}
+	return true
+}
+
+// checkBounds reports lower bounds of rules greater than their upper bounds
+func (f *fieldCheck) checkBounds(rules []parse.Rule) {
+	var min, max, decMin, decMax *parse.Rule
+	for i := range rules {
+		switch rules[i].Op {
+		case parse.Min:
+			min = &rules[i]
+		case parse.Max:
+			max = &rules[i]
+		case parse.DecMin:
+			decMin = &rules[i]
+		case parse.DecMax:
+			decMax = &rules[i]
+		}
+	}
+
+	if min != nil && max != nil {
+		if lo, hi, ok := bounds(min.Args, max.Args); ok && lo > hi {
+			f.report("rules %s and %s can't be satisfied together", ruleText(*min), ruleText(*max))
+		}
+	}
+	if decMin != nil && decMax != nil {
+		if rat(decMin.Args.(parse.Decimal)).Cmp(rat(decMax.Args.(parse.Decimal))) > 0 {
+			f.report("rules %s and %s can't be satisfied together", ruleText(*decMin), ruleText(*decMax))
+		}
+	}
+}
+
+// bounds converts args of min and max to numbers if they have the same type
+func bounds(min, max any) (lo, hi float64, ok bool) {
+	switch lo := min.(type) {
+	case int:
+		hi, ok := max.(int)
+		return float64(lo), float64(hi), ok
+	case float64:
+		hi, ok := max.(float64)
+		return lo, hi, ok
+	case time.Duration:
+		hi, ok := max.(time.Duration)
+		return float64(lo), float64(hi), ok
+	}
+	return 0, 0, false
+}
+
+func rat(d parse.Decimal) *big.Rat {
+	ten := big.NewInt(10)
+	exp := big.NewInt(int64(d.Exp))
+	if d.Exp >= 0 {
+		return new(big.Rat).SetInt(new(big.Int).Mul(d.Coef, new(big.Int).Exp(ten, exp, nil)))
+	}
+	return new(big.Rat).SetFrac(d.Coef, new(big.Int).Exp(ten, exp.Neg(exp), nil))
+}
+
+// unsatisfiable returns why valid rule fails for any value or empty string
+func unsatisfiable(rule parse.Rule) string {
+	switch rule.Op {
+	case parse.Length:
+		if rule.Args.(int) < 0 {
+			return "requires negative length"
+		}
+	case parse.LenInterval:
+		if bounds := rule.Args.([2]int); bounds[0] > bounds[1] {
+			return "has lower bound greater than upper bound"
+		} else if bounds[1] < 0 {
+			return "requires negative length"
+		}
+	}
+	return ""
+}
+
+// wrongReason describes why rule is invalid
+func wrongReason(rule parse.Rule) string {
+	op, _ := parse.ValidationParams(rule.Name)
+	switch {
+	case rule.Name == "":
+		return "tag has no rules"
+	case strings.Count(rule.Name, "'")%2 != 0:
+		return "unterminated quote"
+	case !parse.IsBuiltin(rule.Name) && !parse.IsCustom(op):
+		return fmt.Sprintf("unknown rule %q", rule.Name)
+	case rule.Name == "omitempty" && rule.Params == nil:
+		return "omitempty conflicts with required, nonzero and required_if"
+	}
+	if op, _ = parse.ValidationParams(ruleText(rule)); op == parse.LenMode {
+		return "lenmode is set several times"
+	}
+	return fmt.Sprintf("invalid rule %q", ruleText(rule))
+}
+
+// applicable reports whether Validate can apply rule to value of type t and
+// returns type of values rule is applied to. Zero value of the same kind is
+// checked by check package, so the answer is the same as at runtime. Rules
+// applied to interfaces are not checked, since dynamic type of the value is
+// unknown
+func applicable(t types.Type, rule parse.Rule, collection bool) (types.Type, bool) {
+	if collection {
+		v := reflect.ValueOf([]struct{}{})
+		if _, ok := t.Underlying().(*types.Map); ok {
+			v = reflect.ValueOf(map[struct{}]struct{}{})
+		}
+		return t, !errors.Is(check.ValidCollection(v, rule), check.ErrInvalidFieldType)
+	}
+
+	// rules without dive are applied to every element of slices and arrays
+	for {
+		switch u := t.Underlying().(type) {
+		case *types.Slice:
+			t = gotypes.Deref(u.Elem())
+			continue
+		case *types.Array:
+			t = gotypes.Deref(u.Elem())
+			continue
+		}
+		break
+	}
+
+	v, ok := zero(t)
+	if !ok {
+		return t, true
+	}
+	return t, !errors.Is(check.ValidValue(v, rule), check.ErrInvalidFieldType)
+}
+
+var basicTypes = map[types.BasicKind]reflect.Type{
+	types.Bool:       reflect.TypeOf(false),
+	types.Int:        reflect.TypeOf(int(0)),
+	types.Int8:       reflect.TypeOf(int8(0)),
+	types.Int16:      reflect.TypeOf(int16(0)),
+	types.Int32:      reflect.TypeOf(int32(0)),
+	types.Int64:      reflect.TypeOf(int64(0)),
+	types.Uint:       reflect.TypeOf(uint(0)),
+	types.Uint8:      reflect.TypeOf(uint8(0)),
+	types.Uint16:     reflect.TypeOf(uint16(0)),
+	types.Uint32:     reflect.TypeOf(uint32(0)),
+	types.Uint64:     reflect.TypeOf(uint64(0)),
+	types.Uintptr:    reflect.TypeOf(uintptr(0)),
+	types.Float32:    reflect.TypeOf(float32(0)),
+	types.Float64:    reflect.TypeOf(float64(0)),
+	types.Complex64:  reflect.TypeOf(complex64(0)),
+	types.Complex128: reflect.TypeOf(complex128(0)),
+	types.String:     reflect.TypeOf(""),
+}
+
+// zero returns zero value which check package handles the same way as values
+// of type t or false if kind of the values is unknown
+func zero(t types.Type) (reflect.Value, bool) {
+	switch {
+	case isNamed(t, "time", "Time"):
+		return reflect.ValueOf(time.Time{}), true
+	case isNamed(t, "time", "Duration"):
+		return reflect.ValueOf(time.Duration(0)), true
+	case gotypes.IsDecimal(t) || gotypes.IsDecimal(types.NewPointer(t)):
+		return reflect.ValueOf(parse.Decimal{Coef: new(big.Int)}), true
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		if rt, ok := basicTypes[u.Kind()]; ok {
+			return reflect.Zero(rt), true
+		}
+	case *types.Struct:
+		return reflect.ValueOf(struct{}{}), true
+	case *types.Map:
+		return reflect.ValueOf(map[struct{}]struct{}{}), true
+	case *types.Chan:
+		return reflect.ValueOf((chan struct{})(nil)), true
+	case *types.Signature:
+		return reflect.ValueOf((func())(nil)), true
+	}
+	return reflect.Value{}, false
+}
+
+func isNamed(t types.Type, pkg, name string) bool {
+	n, ok := t.(*types.Named)
+	return ok && n.Obj().Pkg() != nil && n.Obj().Pkg().Path() == pkg && n.Obj().Name() == name
+}
+
+// ruleText returns rule as it is written in tag
+func ruleText(rule parse.Rule) string {
+	if len(rule.Params) == 0 {
+		return rule.Name
+	}
+	return rule.Name + ":" + strings.Join(rule.Params, ",")
+}
+
+func (f *fieldCheck) typeString(t types.Type) string {
+	return types.TypeString(t, types.RelativeTo(f.pass.Pkg))
+}
@@ -0,0 +1,16 @@
+package lint_test
+
+import (
+	"testing"
+
+	"github.com/papey08/golang-fintech/validation/lint"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	if err := lint.Analyzer.Flags.Set("custom", "country"); err != nil {
+		t.Fatal(err)
+	}
+	analysistest.Run(t, analysistest.TestData(), lint.Analyzer, "tags")
+}
@@ -0,0 +1,74 @@
+package tags
+
+import (
+	"math/big"
+	"time"
+)
+
+type Title string
+
+// Cents implements check.Decimal
+type Cents struct {
+	Units int64
+}
+
+func (c Cents) Coefficient() *big.Int { return big.NewInt(c.Units) }
+func (c Cents) Exponent() int32       { return -2 }
+
+type Limits struct {
+	Max int
+}
+
+type Valid struct {
+	Name     string            `validate:"required;min:1;max:20 lenmode:graphemes"`
+	Title    *Title            `validate:"omitempty;in:a,b"`
+	Age      int               `validate:"min:18;max:120"`
+	Ratio    float64           `validate:"positive;max:0.5"`
+	Price    Cents             `validate:"positive;scale:2;decmin:0.01;decmax:100"`
+	Amount   string            `validate:"decmin:-1.5;decmax:+10"`
+	Tags     []string          `validate:"min:1;max:10"`
+	Codes    map[string][]int  `validate:"max:5;dive;keys;len:2;endkeys;dive;min:0"`
+	Grid     [][]*int          `validate:"dive;len:2;dive;max:9"`
+	Start    time.Time         `validate:"after:2020-01-01;weekday tz:Europe/Moscow"`
+	Timeout  time.Duration     `validate:"min:1s;max:1m"`
+	Country  string            `validate:"country:RU"`
+	Limits   *Limits           `validate:"nonzero"`
+	Total    int               `validate:"ltefield:Limits.Max"`
+	Reason   string            `validate:"required_if:Age,18"`
+	Any      any               `validate:"min:1;dive;len:1"`
+	Extra    map[string]string `validate:"required"`
+	internal string
+}
+
+type Invalid struct {
+	Length   string        `validate:"lenght:10"`                   // want `validate tag of Length: unknown rule "lenght"`
+	In       int           `validate:"in:5-"`                       // want `validate tag of In: rule in:5- can't be applied to int`
+	Len      int           `validate:"len:10"`                      // want `validate tag of Len: rule len:10 can't be applied to int`
+	Interval string        `validate:"lenInterval:5,1"`             // want `validate tag of Interval: rule lenInterval:5,1 has lower bound greater than upper bound`
+	Negative []string      `validate:"dive;len:-1"`                 // want `validate tag of Negative: rule len:-1 requires negative length`
+	Bounds   int           `validate:"min:10;max:1"`                // want `validate tag of Bounds: rules min:10 and max:1 can't be satisfied together`
+	Money    Cents         `validate:"decmin:10;decmax:9.99"`       // want `validate tag of Money: rules decmin:10 and decmax:9.99 can't be satisfied together`
+	Regexp   string        `validate:"regexp:a(b"`                  // want `validate tag of Regexp: invalid rule "regexp:a\(b"`
+	Quote    string        `validate:"in:'a"`                       // want `validate tag of Quote: unterminated quote`
+	Empty    string        `validate:""`                            // want `validate tag of Empty: tag has no rules`
+	Omit     string        `validate:"omitempty;required"`          // want `validate tag of Omit: omitempty conflicts with required, nonzero and required_if`
+	Mode     string        `validate:"lenmode:bytes;lenmode:runes"` // want `validate tag of Mode: lenmode is set several times`
+	Date     time.Time     `validate:"min:1"`                       // want `validate tag of Date: rule min:1 can't be applied to time.Time`
+	Timeout  time.Duration `validate:"before:now"`                  // want `validate tag of Timeout: rule before:now can't be applied to time.Duration`
+	Ratio    float64       `validate:"scale:2"`                     // want `validate tag of Ratio: rule scale:2 can't be applied to float64`
+	Items    []Limits      `validate:"min:1"`                       // want `validate tag of Items: rule min:1 can't be applied to Limits, elements of \[\]Limits`
+	Names    []string      `validate:"email;dive"`                  // want `validate tag of Names: rule email can't be applied to \[\]string as a whole, only to its elements after dive`
+	Dive     int           `validate:"dive;min:1"`                  // want `validate tag of Dive: dive is applied to int, which is not a collection`
+	Keys     []string      `validate:"dive;keys;min:1;endkeys"`     // want `validate tag of Keys: keys is applied to slice, which has no keys`
+	EndKeys  string        `validate:"endkeys"`                     // want `validate tag of EndKeys: rule endkeys is out of place`
+	Ref      int           `validate:"eqfield:Missing"`             // want `validate tag of Ref: rule eqfield:Missing refers to Missing, which is not an exported field`
+	Hidden   int           `validate:"gtfield:hidden"`              // want `validate tag of Hidden: rule gtfield:hidden refers to hidden, which is not an exported field`
+	Elems    []int         `validate:"dive;eqfield:Ref"`            // want `validate tag of Elems: cross-field rule eqfield can't be applied to keys and elements`
+	hidden   int           `validate:"min:1"`                       // want `validate tag of hidden: unexported fields can't be validated`
+}
+
+func local() {
+	_ = struct {
+		Code string `validate:"len:three"` // want `validate tag of Code: invalid rule "len:three"`
+	}{}
+}
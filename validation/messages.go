@@ -0,0 +1,307 @@
+package go_course_validation
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/papey08/golang-fintech/validation/check"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultLocale is used when there are no messages for requested locale
+const DefaultLocale = "en"
+
+var ErrInvalidLocale = errors.New("locale should not be empty")
+
+// MessageData is passed to templates of messages
+type MessageData struct {
+	Field string   // full path to the field
+	Label string   // label of the field or path if there is no label
+	Rule  string   // name of the failed rule
+	Args  []string // args of the failed rule
+	Value string   // value of the field formatted for users
+}
+
+// Keys of messages for errors which don't depend on the rule. Messages for
+// failed rules are keyed by name of the rule, messages for strings and
+// collections may be specialized with ".string" and ".collection" suffixes,
+// e.g. "min.string"
+const (
+	syntaxMessage     = "syntax"     // ErrInvalidValidatorSyntax
+	unexportedMessage = "unexported" // ErrValidateForUnexportedFields
+	typeMessage       = "type"       // check.ErrInvalidFieldType
+	defaultMessage    = "default"    // rule without its own message
+)
+
+var messageFuncs = template.FuncMap{"join": strings.Join}
+
+var (
+	messagesMu sync.RWMutex
+	messages   = make(map[string]map[string]*template.Template) // by locale and key
+)
+
+func init() {
+	for locale, texts := range builtinMessages {
+		for key, text := range texts {
+			if err := RegisterMessage(locale, key, text); err != nil {
+				panic(err)
+			}
+		}
+	}
+}
+
+// RegisterMessage adds or replaces template of message with key for locale.
+// Key is a name of rule, e.g. "min" or "min.string", or one of "syntax",
+// "unexported", "type" and "default". Template gets MessageData and may use
+// join function: {{join .Args ", "}}
+func RegisterMessage(locale, key, text string) error {
+	locale = normalizeLocale(locale)
+	if locale == "" {
+		return ErrInvalidLocale
+	}
+	tmpl, err := template.New(key).Funcs(messageFuncs).Parse(text)
+	if err != nil {
+		return errors.Wrap(err, "invalid message template")
+	}
+
+	messagesMu.Lock()
+	defer messagesMu.Unlock()
+	if messages[locale] == nil {
+		messages[locale] = make(map[string]*template.Template)
+	}
+	messages[locale][key] = tmpl
+	return nil
+}
+
+// Localize returns copy of v with Message in locale, e.g. "ru" or "en-US".
+// Locales without messages fall back to DefaultLocale, errors without
+// message in the catalogue keep text of Err
+func (v ValidationError) Localize(locale string) ValidationError {
+	data := MessageData{
+		Field: v.Field,
+		Label: v.Label,
+		Rule:  v.Rule,
+		Args:  v.Args,
+	}
+	if data.Label == "" {
+		data.Label = v.Field
+	}
+	if v.Value != nil {
+		data.Value = formatValue(v.Value)
+	}
+
+	v.Message = v.cause().Error()
+	if tmpl := lookupMessage(locale, messageKeys(v)); tmpl != nil {
+		var res strings.Builder
+		if err := tmpl.Execute(&res, data); err == nil {
+			v.Message = res.String()
+		}
+	}
+	return v
+}
+
+// Localize returns copy of v with messages in locale
+func (v ValidationErrors) Localize(locale string) ValidationErrors {
+	res := make(ValidationErrors, len(v))
+	for i := range v {
+		res[i] = v[i].Localize(locale)
+	}
+	return res
+}
+
+// messageKeys returns keys of messages suitable for v from the most specific
+func messageKeys(v ValidationError) []string {
+	switch {
+	case errors.Is(v.Err, ErrInvalidValidatorSyntax):
+		return []string{syntaxMessage}
+	case errors.Is(v.Err, ErrValidateForUnexportedFields):
+		return []string{unexportedMessage}
+	case errors.Is(v.Err, check.ErrInvalidFieldType):
+		return []string{typeMessage}
+	case v.Rule == "":
+		return nil // error of Validate method has its own text
+	}
+
+	keys := []string{v.Rule}
+	switch reflect.ValueOf(v.Value).Kind() {
+	case reflect.String:
+		keys = []string{v.Rule + ".string", v.Rule}
+	case reflect.Slice, reflect.Array, reflect.Map:
+		keys = []string{v.Rule + ".collection", v.Rule}
+	}
+	if errors.Is(v.cause(), check.ErrInvalidFieldValue) {
+		keys = append(keys, defaultMessage)
+	}
+	return keys
+}
+
+// lookupMessage returns template of message with the first of keys found for
+// locale, its base language or DefaultLocale
+func lookupMessage(locale string, keys []string) *template.Template {
+	messagesMu.RLock()
+	defer messagesMu.RUnlock()
+	for _, l := range []string{normalizeLocale(locale), baseLanguage(locale), DefaultLocale} {
+		for _, key := range keys {
+			if tmpl, ok := messages[l][key]; ok {
+				return tmpl
+			}
+		}
+	}
+	return nil
+}
+
+// ParseAcceptLanguage returns the most preferred locale from value of
+// Accept-Language header which has messages, e.g. "ru" for
+// "ru-RU,ru;q=0.9,en;q=0.8". Returns DefaultLocale if there is no such locale
+func ParseAcceptLanguage(header string) string {
+	type weighted struct {
+		locale string
+		q      float64
+	}
+
+	var locales []weighted
+	for _, part := range strings.Split(header, ",") {
+		locale, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		q := 1.0
+		if params = strings.TrimSpace(params); strings.HasPrefix(params, "q=") {
+			var err error
+			if q, err = strconv.ParseFloat(strings.TrimPrefix(params, "q="), 64); err != nil {
+				continue
+			}
+		}
+		if locale = strings.TrimSpace(locale); locale != "" && q > 0 {
+			locales = append(locales, weighted{locale: locale, q: q})
+		}
+	}
+	sort.SliceStable(locales, func(i, j int) bool {
+		return locales[i].q > locales[j].q
+	})
+
+	messagesMu.RLock()
+	defer messagesMu.RUnlock()
+	for _, w := range locales {
+		if w.locale == "*" {
+			return DefaultLocale
+		}
+		for _, l := range []string{normalizeLocale(w.locale), baseLanguage(w.locale)} {
+			if _, ok := messages[l]; ok {
+				return l
+			}
+		}
+	}
+	return DefaultLocale
+}
+
+// normalizeLocale converts locale to the form used in the catalogue: "en-us"
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
+
+// baseLanguage returns language of locale without region: "en" for "en-US"
+func baseLanguage(locale string) string {
+	language, _, _ := strings.Cut(normalizeLocale(locale), "-")
+	return language
+}
+
+// builtinMessages contains messages for built-in rules
+var builtinMessages = map[string]map[string]string{
+	"en": {
+		syntaxMessage:     `{{.Label}} has invalid validation rule {{.Rule}}`,
+		unexportedMessage: `{{.Label}} can't be validated because it is unexported`,
+		typeMessage:       `rule {{.Rule}} can't be applied to {{.Label}}`,
+		defaultMessage:    `{{.Label}} is invalid`,
+
+		"len.string":             `{{.Label}} must be exactly {{index .Args 0}} characters long`,
+		"len.collection":         `{{.Label}} must contain exactly {{index .Args 0}} items`,
+		"in":                     `{{.Label}} must be one of {{join .Args ", "}}`,
+		"min":                    `{{.Label}} must be at least {{index .Args 0}}`,
+		"min.string":             `{{.Label}} must be at least {{index .Args 0}} characters long`,
+		"min.collection":         `{{.Label}} must contain at least {{index .Args 0}} items`,
+		"max":                    `{{.Label}} must be at most {{index .Args 0}}`,
+		"max.string":             `{{.Label}} must be at most {{index .Args 0}} characters long`,
+		"max.collection":         `{{.Label}} must contain at most {{index .Args 0}} items`,
+		"lenInterval.string":     `{{.Label}} must be from {{index .Args 0}} to {{index .Args 1}} characters long`,
+		"lenInterval.collection": `{{.Label}} must contain from {{index .Args 0}} to {{index .Args 1}} items`,
+		"regexp":                 `{{.Label}} must match pattern {{index .Args 0}}`,
+		"email":                  `{{.Label}} must be a valid email address`,
+		"url":                    `{{.Label}} must be a valid URL`,
+		"uuid":                   `{{.Label}} must be a valid UUID`,
+		"phone":                  `{{.Label}} must be a valid phone number`,
+		"alpha":                  `{{.Label}} must contain only letters`,
+		"alnum":                  `{{.Label}} must contain only letters and digits`,
+		"ascii":                  `{{.Label}} must contain only ASCII characters`,
+		"numeric":                `{{.Label}} must contain only digits`,
+		"hex":                    `{{.Label}} must contain only hexadecimal digits`,
+		"before":                 `{{.Label}} must be before {{index .Args 0}}`,
+		"after":                  `{{.Label}} must be after {{index .Args 0}}`,
+		"within":                 `{{.Label}} must be within {{index .Args 0}} from now`,
+		"weekday":                `{{.Label}} must {{if .Args}}fall on {{join .Args ", "}}{{else}}be a weekday{{end}}`,
+		"positive":               `{{.Label}} must be greater than zero`,
+		"scale":                  `{{.Label}} must have at most {{index .Args 0}} fractional digits`,
+		"precision":              `{{.Label}} must have at most {{index .Args 0}} digits`,
+		"currency":               `{{.Label}} must be {{if and .Args (ne (index .Args 0) "ISO4217")}}one of {{join .Args ", "}}{{else}}a valid ISO 4217 currency code{{end}}`,
+		"decmin":                 `{{.Label}} must be at least {{index .Args 0}}`,
+		"decmax":                 `{{.Label}} must be at most {{index .Args 0}}`,
+		"required":               `{{.Label}} is required`,
+		"nonzero":                `{{.Label}} must not be empty`,
+		"eqfield":                `{{.Label}} must be equal to {{index .Args 0}}`,
+		"nefield":                `{{.Label}} must not be equal to {{index .Args 0}}`,
+		"gtfield":                `{{.Label}} must be greater than {{index .Args 0}}`,
+		"gtefield":               `{{.Label}} must be greater than or equal to {{index .Args 0}}`,
+		"ltfield":                `{{.Label}} must be less than {{index .Args 0}}`,
+		"ltefield":               `{{.Label}} must be less than or equal to {{index .Args 0}}`,
+		"required_if":            `{{.Label}} is required when {{index .Args 0}} is {{join (slice .Args 1) ", "}}`,
+	},
+	"ru": {
+		syntaxMessage:     `{{.Label}}: некорректное правило валидации {{.Rule}}`,
+		unexportedMessage: `{{.Label}}: неэкспортируемое поле нельзя проверить`,
+		typeMessage:       `{{.Label}}: правило {{.Rule}} неприменимо к типу поля`,
+		defaultMessage:    `{{.Label}}: некорректное значение`,
+
+		"len.string":             `{{.Label}}: длина должна быть равна {{index .Args 0}}`,
+		"len.collection":         `{{.Label}}: количество элементов должно быть равно {{index .Args 0}}`,
+		"in":                     `{{.Label}}: значение должно быть одним из: {{join .Args ", "}}`,
+		"min":                    `{{.Label}}: значение должно быть не меньше {{index .Args 0}}`,
+		"min.string":             `{{.Label}}: длина должна быть не меньше {{index .Args 0}}`,
+		"min.collection":         `{{.Label}}: количество элементов должно быть не меньше {{index .Args 0}}`,
+		"max":                    `{{.Label}}: значение должно быть не больше {{index .Args 0}}`,
+		"max.string":             `{{.Label}}: длина должна быть не больше {{index .Args 0}}`,
+		"max.collection":         `{{.Label}}: количество элементов должно быть не больше {{index .Args 0}}`,
+		"lenInterval.string":     `{{.Label}}: длина должна быть от {{index .Args 0}} до {{index .Args 1}}`,
+		"lenInterval.collection": `{{.Label}}: количество элементов должно быть от {{index .Args 0}} до {{index .Args 1}}`,
+		"regexp":                 `{{.Label}}: значение не соответствует шаблону {{index .Args 0}}`,
+		"email":                  `{{.Label}}: некорректный адрес электронной почты`,
+		"url":                    `{{.Label}}: некорректный URL`,
+		"uuid":                   `{{.Label}}: некорректный UUID`,
+		"phone":                  `{{.Label}}: некорректный номер телефона`,
+		"alpha":                  `{{.Label}}: допустимы только буквы`,
+		"alnum":                  `{{.Label}}: допустимы только буквы и цифры`,
+		"ascii":                  `{{.Label}}: допустимы только символы ASCII`,
+		"numeric":                `{{.Label}}: допустимы только цифры`,
+		"hex":                    `{{.Label}}: допустимы только шестнадцатеричные цифры`,
+		"before":                 `{{.Label}}: дата должна быть раньше {{index .Args 0}}`,
+		"after":                  `{{.Label}}: дата должна быть позже {{index .Args 0}}`,
+		"within":                 `{{.Label}}: дата должна отличаться от текущей не больше чем на {{index .Args 0}}`,
+		"weekday":                `{{.Label}}: {{if .Args}}допустимые дни недели: {{join .Args ", "}}{{else}}дата должна приходиться на будний день{{end}}`,
+		"positive":               `{{.Label}}: значение должно быть больше нуля`,
+		"scale":                  `{{.Label}}: допустимо не больше {{index .Args 0}} знаков после запятой`,
+		"precision":              `{{.Label}}: допустимо не больше {{index .Args 0}} цифр`,
+		"currency":               `{{.Label}}: {{if and .Args (ne (index .Args 0) "ISO4217")}}допустимые валюты: {{join .Args ", "}}{{else}}некорректный код валюты ISO 4217{{end}}`,
+		"decmin":                 `{{.Label}}: значение должно быть не меньше {{index .Args 0}}`,
+		"decmax":                 `{{.Label}}: значение должно быть не больше {{index .Args 0}}`,
+		"required":               `{{.Label}}: поле обязательно`,
+		"nonzero":                `{{.Label}}: значение не должно быть пустым`,
+		"eqfield":                `{{.Label}}: значение должно совпадать с полем {{index .Args 0}}`,
+		"nefield":                `{{.Label}}: значение не должно совпадать с полем {{index .Args 0}}`,
+		"gtfield":                `{{.Label}}: значение должно быть больше значения поля {{index .Args 0}}`,
+		"gtefield":               `{{.Label}}: значение должно быть не меньше значения поля {{index .Args 0}}`,
+		"ltfield":                `{{.Label}}: значение должно быть меньше значения поля {{index .Args 0}}`,
+		"ltefield":               `{{.Label}}: значение должно быть не больше значения поля {{index .Args 0}}`,
+		"required_if":            `{{.Label}}: поле обязательно, если {{index .Args 0}} равно {{join (slice .Args 1) ", "}}`,
+	},
+}
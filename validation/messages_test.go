@@ -0,0 +1,104 @@
+package go_course_validation
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/papey08/golang-fintech/validation/check"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type AdForm struct {
+	Title  string   `validate:"min:3" label:"Заголовок"`
+	Price  int      `validate:"min:1" label:"Цена"`
+	Tags   []string `validate:"max:2;dive;max:10" label:"Теги"`
+	Email  string   `validate:"email"`
+	Status string   `validate:"in:draft,published"`
+	Code   string   `validate:"unknown"`
+	Count  int      `validate:"len:1"`
+}
+
+func TestLocalize(t *testing.T) {
+	err := Validate(AdForm{
+		Title:  "ab",
+		Tags:   []string{"a", "b", "очень длинный тег"},
+		Email:  "seller",
+		Status: "sold",
+		Code:   "x",
+	})
+	var ve ValidationErrors
+	assert.True(t, errors.As(err, &ve))
+
+	assert.Equal(t, []string{
+		"Заголовок: длина должна быть не меньше 3",
+		"Цена: значение должно быть не меньше 1",
+		"Теги: количество элементов должно быть не больше 2",
+		"Теги: длина должна быть не больше 10",
+		"Email: некорректный адрес электронной почты",
+		"Status: значение должно быть одним из: draft, published",
+		"Code: некорректное правило валидации unknown",
+		"Count: правило len неприменимо к типу поля",
+	}, messagesOf(ve.Localize("ru-RU")))
+
+	assert.Equal(t, []string{
+		"Заголовок must be at least 3 characters long",
+		"Цена must be at least 1",
+		"Теги must contain at most 2 items",
+		"Теги must be at most 10 characters long",
+		"Email must be a valid email address",
+		"Status must be one of draft, published",
+		"Code has invalid validation rule unknown",
+		"rule len can't be applied to Count",
+	}, messagesOf(ve.Localize("de")))
+
+	localized := ve.Localize("ru")
+	assert.Equal(t, "Заголовок: длина должна быть не меньше 3", localized[0].Error())
+	assert.True(t, errors.Is(localized, ErrInvalidValidatorSyntax))
+
+	data, jsonErr := json.Marshal(localized[:1])
+	assert.NoError(t, jsonErr)
+	assert.JSONEq(t, `[
+		{"field": "Title", "rule": "min", "args": ["3"], "value": "ab", "message": "Заголовок: длина должна быть не меньше 3"}
+	]`, string(data))
+}
+
+func TestRegisterMessage(t *testing.T) {
+	assert.ErrorIs(t, RegisterMessage("", "min", "text"), ErrInvalidLocale)
+	assert.Error(t, RegisterMessage("en", "min", "{{.Label"))
+
+	assert.NoError(t, RegisterMessage("kk", "default", "{{.Label}}: мән дұрыс емес ({{.Value}})"))
+	ve := ValidationError{Field: "Price", Rule: "min", Args: []string{"1"}, Value: 0, Err: check.ErrInvalidFieldValue}
+	assert.Equal(t, "Price: мән дұрыс емес (0)", ve.Localize("kk-KZ").Message)
+
+	// error of Validate method keeps its text
+	hookErr := ValidationError{Field: "Items", Err: errors.New("cart is empty")}
+	assert.Equal(t, "cart is empty", hookErr.Localize("ru").Message)
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{"", DefaultLocale},
+		{"ru-RU,ru;q=0.9,en-US;q=0.8,en;q=0.7", "ru"},
+		{"de-DE,de;q=0.9,en;q=0.5,ru;q=0.8", "ru"},
+		{"fr;q=0.1,*;q=0.5", DefaultLocale},
+		{"ru;q=0,en", "en"},
+		{"ru;q=abc,en;q=0.1", "en"},
+		{"EN_us", "en"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, ParseAcceptLanguage(tt.header), tt.header)
+	}
+}
+
+func messagesOf(ve ValidationErrors) []string {
+	res := make([]string, len(ve))
+	for i := range ve {
+		res[i] = ve[i].Message
+	}
+	return res
+}
@@ -0,0 +1,118 @@
+package mod
+
+import (
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/papey08/golang-fintech/validation/check"
+	"github.com/papey08/golang-fintech/validation/parse"
+)
+
+// htmlRegexp matches tags, comments and contents of script and style elements
+var htmlRegexp = regexp.MustCompile(`(?is)<script\b.*?</script\s*>|<style\b.*?</style\s*>|<!--.*?-->|<[^>]*>`)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// Apply modifies settable value according to modifier. String modifiers
+// require value of string kind, default sets zero value of any basic kind
+func Apply(value reflect.Value, m parse.Modifier) error {
+	if m.Op == parse.Default {
+		return setDefault(value, m.Arg)
+	}
+	if value.Kind() != reflect.String {
+		return check.ErrInvalidFieldType
+	}
+
+	s := value.String()
+	switch m.Op {
+	case parse.Trim:
+		s = strings.TrimSpace(s)
+	case parse.Lower:
+		s = strings.ToLower(s)
+	case parse.Upper:
+		s = strings.ToUpper(s)
+	case parse.CollapseSpaces:
+		s = collapseSpaces(s)
+	case parse.StripHTML:
+		s = htmlRegexp.ReplaceAllString(s, "")
+	default:
+		return check.ErrInvalidFieldType
+	}
+	value.SetString(s)
+	return nil
+}
+
+// setDefault sets value to arg converted to its type if value is zero
+func setDefault(value reflect.Value, arg string) error {
+	if !value.IsZero() {
+		return nil
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(arg)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if value.Type() == durationType {
+			d, err := time.ParseDuration(arg)
+			if err != nil {
+				return check.ErrInvalidFieldType
+			}
+			value.SetInt(int64(d))
+			return nil
+		}
+		n, err := strconv.ParseInt(arg, 10, value.Type().Bits())
+		if err != nil {
+			return check.ErrInvalidFieldType
+		}
+		value.SetInt(n)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(arg, 10, value.Type().Bits())
+		if err != nil {
+			return check.ErrInvalidFieldType
+		}
+		value.SetUint(n)
+
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(arg, value.Type().Bits())
+		if err != nil {
+			return check.ErrInvalidFieldType
+		}
+		value.SetFloat(f)
+
+	case reflect.Bool:
+		b, err := strconv.ParseBool(arg)
+		if err != nil {
+			return check.ErrInvalidFieldType
+		}
+		value.SetBool(b)
+
+	default:
+		return check.ErrInvalidFieldType
+	}
+	return nil
+}
+
+// collapseSpaces replaces every sequence of whitespaces with a single space
+func collapseSpaces(s string) string {
+	var res strings.Builder
+	res.Grow(len(s))
+	space := false
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			if !space {
+				res.WriteRune(' ')
+			}
+			space = true
+			continue
+		}
+		space = false
+		res.WriteRune(r)
+	}
+	return res.String()
+}
@@ -0,0 +1,87 @@
+package go_course_validation
+
+import (
+	"context"
+	"reflect"
+)
+
+// Option configures a single validation started by ValidateWith
+type Option func(*options)
+
+// options of a single validation
+type options struct {
+	ctx       context.Context
+	maxErrors int      // zero means that every error is collected
+	groups    []string // active groups
+}
+
+func newOptions(opts []Option) options {
+	o := options{ctx: context.Background()}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithContext sets ctx which is passed to rules registered by
+// RegisterValidationCtx and to ValidateContext methods
+func WithContext(ctx context.Context) Option {
+	return func(o *options) {
+		o.ctx = ctx
+	}
+}
+
+// FailFast stops validation at the first error, the same as MaxErrors(1)
+func FailFast() Option {
+	return MaxErrors(1)
+}
+
+// MaxErrors stops validation as soon as n errors are found, non-positive n
+// means that every error is collected
+func MaxErrors(n int) Option {
+	return func(o *options) {
+		if n < 0 {
+			n = 0
+		}
+		o.maxErrors = n
+	}
+}
+
+// CollectAll makes validation collect every error, it is the default mode
+// which cancels FailFast and MaxErrors given before
+func CollectAll() Option {
+	return MaxErrors(0)
+}
+
+// WithGroups makes rules of fields with groups tag checked if one of their
+// groups is in groups. Fields without groups tag are always checked, rules of
+// the other fields are skipped unless their group is active. Repeated
+// WithGroups replaces active groups
+func WithGroups(groups ...string) Option {
+	return func(o *options) {
+		o.groups = groups
+	}
+}
+
+// ValidateWith checks fields of struct v the same way as Validate does, but
+// according to opts. Fields and elements are checked in the same order as
+// errors are reported by Validate, so the first n errors are the same.
+// Asynchronous rules are not run if the limit is reached before them
+func ValidateWith(v any, opts ...Option) error {
+	rv, err := structValue(reflect.ValueOf(v))
+	if err != nil {
+		return err
+	}
+	return validateRoot(rv, planOf(rv.Type()), newOptions(opts))
+}
+
+// record counts n found errors and reports whether validation should stop
+func (s *validation) record(n int) bool {
+	s.found += n
+	return s.stopped()
+}
+
+// stopped reports whether the limit of errors is reached
+func (s *validation) stopped() bool {
+	return s.maxErrors > 0 && s.found >= s.maxErrors
+}
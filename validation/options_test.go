@@ -0,0 +1,199 @@
+package go_course_validation
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/papey08/golang-fintech/validation/check"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type Line struct {
+	SKU   string `validate:"len:8"`
+	Qty   int    `validate:"min:1"`
+	calls *int
+}
+
+// Validate counts its calls, so tests can check that traversal stops
+func (l Line) Validate() error {
+	if l.calls != nil {
+		*l.calls++
+	}
+	return nil
+}
+
+type Shipment struct {
+	Lines []Line
+	Spare map[string]*Line
+}
+
+type Batch struct {
+	ID        int `validate:"min:1"`
+	Shipments []Shipment
+	Checkout  *Checkout
+}
+
+// invalidBatch returns batch with errors at every level of nesting, calls
+// counts calls of Validate of its lines
+func invalidBatch(calls *int) *Batch {
+	line := func(sku string, qty int) Line {
+		return Line{SKU: sku, Qty: qty, calls: calls}
+	}
+	spare := line("", 1)
+	return &Batch{
+		ID: 0,
+		Shipments: []Shipment{
+			{Lines: []Line{line("AB-00001", 1), line("short", 0)}},
+			{Lines: []Line{line("AB-00002", -1)}, Spare: map[string]*Line{"a": &spare, "b": nil}},
+		},
+		Checkout: &Checkout{Cart: Cart{Positions: []Position{{Price: 1, Discount: 2}}, Total: 5}},
+	}
+}
+
+func TestValidateWith(t *testing.T) {
+	var calls int
+	all := Validate(invalidBatch(&calls))
+	var ve ValidationErrors
+	assert.True(t, errors.As(all, &ve))
+	assert.Equal(t, []string{
+		"ID",
+		"Shipments[0].Lines[1].SKU",
+		"Shipments[0].Lines[1].Qty",
+		"Shipments[1].Lines[0].Qty",
+		"Shipments[1].Spare[a].SKU",
+		"Checkout.Cart.Owner",
+		"Checkout.Cart.Positions[0]",
+		"Checkout.Cart.Total",
+		"Checkout.Session.User",
+	}, fields(ve))
+	assert.Equal(t, 4, calls)
+
+	t.Run("collect all", func(t *testing.T) {
+		assert.Equal(t, all, ValidateWith(invalidBatch(nil)))
+		assert.Equal(t, all, ValidateWith(invalidBatch(nil), CollectAll()))
+		assert.Equal(t, all, ValidateWith(invalidBatch(nil), MaxErrors(100)))
+		assert.Equal(t, all, ValidateWith(invalidBatch(nil), FailFast(), MaxErrors(0)))
+	})
+
+	t.Run("fail fast", func(t *testing.T) {
+		calls = 0
+		assert.Equal(t, ve[:1], ValidateWith(invalidBatch(&calls), FailFast()))
+		assert.Zero(t, calls)
+
+		b := invalidBatch(&calls)
+		b.ID = 1
+		calls = 0
+		assert.Equal(t, ve[1:2], ValidateWith(b, MaxErrors(3), FailFast()))
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("max errors", func(t *testing.T) {
+		for n := 1; n <= len(ve); n++ {
+			assert.Equal(t, ve[:n], ValidateWith(invalidBatch(nil), MaxErrors(n)))
+		}
+
+		// struct-level check reports several errors at once
+		calls = 0
+		assert.Equal(t, ve[:7], ValidateWith(invalidBatch(&calls), MaxErrors(7)))
+		assert.Equal(t, 4, calls)
+	})
+
+	t.Run("compiled", func(t *testing.T) {
+		vr, compileErr := Compile[*Batch]()
+		assert.NoError(t, compileErr)
+		assert.Equal(t, ve[:2], vr.ValidateWith(invalidBatch(nil), MaxErrors(2)))
+		assert.Equal(t, all, vr.ValidateWith(invalidBatch(nil)))
+	})
+
+	t.Run("context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.ErrorIs(t, ValidateWith(invalidBatch(nil), WithContext(ctx), FailFast()), context.Canceled)
+		assert.ErrorIs(t, ValidateWith(nil, FailFast()), ErrNilValue)
+		assert.NoError(t, ValidateWith(&Item{Name: "pen"}, FailFast()))
+	})
+}
+
+func TestValidateWithAsyncRules(t *testing.T) {
+	var checked int
+	assert.NoError(t, RegisterValidationCtx("counted_async", func(_ context.Context, _ reflect.Value, _ []string) error {
+		checked++
+		return check.ErrInvalidFieldValue
+	}))
+
+	type Comment struct {
+		Text   string `validate:"min:1"`
+		Author string `validate:"counted_async"`
+	}
+
+	// asynchronous rules are not run when the limit is already reached
+	err := ValidateWith(&Comment{}, FailFast())
+	assert.Len(t, err.(ValidationErrors), 1)
+	assert.Zero(t, checked)
+
+	err = ValidateWith(&Comment{}, MaxErrors(2))
+	assert.Len(t, err.(ValidationErrors), 2)
+	assert.Equal(t, 1, checked)
+}
+
+func fields(ve ValidationErrors) []string {
+	res := make([]string, len(ve))
+	for i := range ve {
+		res[i] = ve[i].Field
+	}
+	return res
+}
+
+type AdAuthor struct {
+	Email string `validate:"email" groups:"create"`
+	Name  string `validate:"min:1"`
+}
+
+// AdInput is validated differently on create and on update
+type AdInput struct {
+	ID     int64     `validate:"min:1" groups:"update"`
+	Title  string    `validate:"min:1;max:99" groups:"create"`
+	Text   string    `validate:"max:5"`
+	Tags   []string  `validate:"dive;min:1" groups:"create, update"`
+	Author *AdAuthor `groups:"create"`
+	note   string    `validate:"min:1" groups:"admin"`
+}
+
+func TestValidateWithGroups(t *testing.T) {
+	input := &AdInput{Text: "too long", Tags: []string{"a", ""}, Author: &AdAuthor{}}
+
+	for _, c := range []struct {
+		groups []string
+		fields []string
+	}{
+		{nil, []string{"Text", "Author.Name"}},
+		{[]string{"create"}, []string{"Title", "Text", "Tags[1]", "Author.Email", "Author.Name"}},
+		{[]string{"update"}, []string{"ID", "Text", "Tags[1]", "Author.Name"}},
+		{[]string{"update", "create"}, []string{"ID", "Title", "Text", "Tags[1]", "Author.Email", "Author.Name"}},
+		{[]string{"admin"}, []string{"Text", "Author.Name", "note"}},
+		{[]string{"unknown"}, []string{"Text", "Author.Name"}},
+	} {
+		var ve ValidationErrors
+		assert.True(t, errors.As(ValidateWith(input, WithGroups(c.groups...)), &ve), c.groups)
+		assert.Equal(t, c.fields, fields(ve), c.groups)
+	}
+
+	// rules without groups are checked by Validate
+	var ve ValidationErrors
+	assert.True(t, errors.As(Validate(input), &ve))
+	assert.Equal(t, []string{"Text", "Author.Name"}, fields(ve))
+
+	assert.NoError(t, ValidateWith(&AdInput{ID: 1, Tags: []string{"a"}, Author: &AdAuthor{Name: "Bob"}}, WithGroups("update")))
+
+	vr, err := Compile[AdInput]()
+	assert.NoError(t, err)
+	err = vr.ValidateWith(*input, WithGroups("create"), FailFast())
+	assert.True(t, errors.As(err, &ve))
+	assert.Equal(t, []string{"Title"}, fields(ve))
+	err = vr.ValidateWith(*input, WithGroups("create"), WithGroups())
+	assert.True(t, errors.As(err, &ve))
+	assert.Equal(t, []string{"Text", "Author.Name"}, fields(ve))
+}
@@ -0,0 +1,78 @@
+package parse
+
+import (
+	"strings"
+	"unicode"
+)
+
+// FieldRef is a path to another field of the same struct, e.g. "Password" or
+// "Period.From" for field of nested struct
+type FieldRef []string
+
+func (r FieldRef) String() string {
+	return strings.Join(r, ".")
+}
+
+// Condition is args of required_if: field is required when field Field of the
+// same struct equals one of Values
+type Condition struct {
+	Field  FieldRef
+	Values []string
+}
+
+// compareOperations contains operations which compare field with another one
+var compareOperations = map[string]ValidationOperation{
+	"eqfield":  EqField,
+	"nefield":  NeField,
+	"gtfield":  GtField,
+	"gtefield": GteField,
+	"ltfield":  LtField,
+	"ltefield": LteField,
+}
+
+// IsCrossField reports whether v refers to another field of the struct, so
+// Rule.Args of it is a FieldRef or a Condition
+func IsCrossField(v ValidationOperation) bool {
+	return EqField <= v && v <= RequiredIf
+}
+
+// parseFieldRef splits dotted path to field, every part of it should be a
+// name of field
+func parseFieldRef(s string) (FieldRef, bool) {
+	if s == "" {
+		return nil, false
+	}
+	ref := FieldRef(strings.Split(s, "."))
+	for _, name := range ref {
+		if !isIdentifier(name) {
+			return nil, false
+		}
+	}
+	return ref, true
+}
+
+// parseCondition parses args of required_if: path to field and at least one
+// value
+func parseCondition(rawArgs string) (Condition, bool) {
+	args, ok := splitArgs(rawArgs)
+	if !ok || len(args) < 2 {
+		return Condition{}, false
+	}
+	ref, ok := parseFieldRef(args[0])
+	if !ok {
+		return Condition{}, false
+	}
+	return Condition{Field: ref, Values: args[1:]}, true
+}
+
+func isIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		if !(r == '_' || unicode.IsLetter(r) || i > 0 && unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return true
+}
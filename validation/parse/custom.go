@@ -0,0 +1,62 @@
+package parse
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+var ErrInvalidOperationName = errors.New("invalid name of validate operation")
+var ErrOperationExists = errors.New("validate operation with such name already exists")
+
+// customOperations contains operations added by RegisterOperation
+var customOperations = struct {
+	sync.RWMutex
+	byName map[string]ValidationOperation
+	next   ValidationOperation
+}{
+	byName: make(map[string]ValidationOperation),
+	next:   firstCustom,
+}
+
+// RegisterOperation adds operation with given name to the grammar of validate
+// tag. Args of such operation are not converted, so Rule.Args of it is a
+// []string. Registering already registered name returns the same operation
+func RegisterOperation(name string) (ValidationOperation, error) {
+	if name == "" || strings.ContainsAny(name, ":;,'\\") || strings.IndexFunc(name, isRuleSeparator) != -1 {
+		return Wrong, ErrInvalidOperationName
+	}
+	if _, ok := builtinNames[name]; ok {
+		return Wrong, ErrOperationExists
+	}
+
+	customOperations.Lock()
+	defer customOperations.Unlock()
+
+	if op, ok := customOperations.byName[name]; ok {
+		return op, nil
+	}
+	op := customOperations.next
+	customOperations.byName[name] = op
+	customOperations.next++
+	return op, nil
+}
+
+// IsCustom reports whether v was added by RegisterOperation
+func IsCustom(v ValidationOperation) bool {
+	return v >= firstCustom
+}
+
+// IsBuiltin reports whether name is a name of built-in operation
+func IsBuiltin(name string) bool {
+	_, ok := builtinNames[name]
+	return ok
+}
+
+func customOperation(name string) (ValidationOperation, bool) {
+	customOperations.RLock()
+	defer customOperations.RUnlock()
+	op, ok := customOperations.byName[name]
+	return op, ok
+}
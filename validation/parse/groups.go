@@ -0,0 +1,27 @@
+package parse
+
+import "strings"
+
+// Groups decomposes groups tag like "create,update" to names of groups.
+// Spaces around names and empty names are dropped
+func Groups(groupsTag string) []string {
+	var groups []string
+	for _, name := range strings.Split(groupsTag, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			groups = append(groups, name)
+		}
+	}
+	return groups
+}
+
+// InGroups reports whether any of groups is in active
+func InGroups(groups, active []string) bool {
+	for _, g := range groups {
+		for _, a := range active {
+			if g == a {
+				return true
+			}
+		}
+	}
+	return false
+}
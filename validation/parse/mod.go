@@ -0,0 +1,58 @@
+package parse
+
+type ModOperation int
+
+const (
+	WrongMod ModOperation = iota // special value for case when mod tag is invalid
+	Trim
+	Lower
+	Upper
+	CollapseSpaces
+	StripHTML
+	Default
+)
+
+// modOperations contains operations of mod tag which have no args
+var modOperations = map[string]ModOperation{
+	"trim":            Trim,
+	"lower":           Lower,
+	"upper":           Upper,
+	"collapse_spaces": CollapseSpaces,
+	"strip_html":      StripHTML,
+}
+
+// Modifier is a single operation of mod tag
+type Modifier struct {
+	Op   ModOperation
+	Name string // name of operation as it is written in tag
+	Arg  string // value of default without quotes
+}
+
+// Modifiers decomposes mod tag to the list of modifiers which are applied in
+// the same order. Modifiers are separated the same way as rules of validate
+// tag: "trim;lower" or "trim default:'no title'"
+func Modifiers(modTag string) []Modifier {
+	tokens, ok := splitUnquoted(modTag, isRuleSeparator)
+	if !ok {
+		return []Modifier{{Op: WrongMod, Name: modTag}}
+	}
+
+	mods := make([]Modifier, 0, len(tokens))
+	for _, token := range tokens {
+		if token == "" { // several separators in a row
+			continue
+		}
+		name, rawArg, found := cutUnquoted(token, ':')
+		mod := Modifier{Op: WrongMod, Name: name}
+		if op, ok := modOperations[name]; ok && !found {
+			mod.Op = op
+		} else if name == "default" && found {
+			mod.Op, mod.Arg = Default, unquote(rawArg)
+		}
+		mods = append(mods, mod)
+	}
+	if len(mods) == 0 {
+		return []Modifier{{Op: WrongMod}}
+	}
+	return mods
+}
@@ -0,0 +1,121 @@
+package parse
+
+import (
+	"math"
+	"math/big"
+	"sort"
+)
+
+// Decimal is an exact decimal number Coef * 10^Exp, it is an arg of decmin
+// and decmax rules
+type Decimal struct {
+	Coef *big.Int
+	Exp  int32
+}
+
+func (d Decimal) Coefficient() *big.Int { return d.Coef }
+func (d Decimal) Exponent() int32       { return d.Exp }
+
+// ParseDecimal parses decimal number like "-1234.50". Exponent notation,
+// separators of thousands and numbers without digits before or after the
+// point are not accepted
+func ParseDecimal(s string) (Decimal, bool) {
+	digits := s
+	if len(digits) > 0 && (digits[0] == '+' || digits[0] == '-') {
+		digits = digits[1:]
+	}
+
+	point := -1
+	for i := 0; i < len(digits); i++ {
+		switch c := digits[i]; {
+		case c >= '0' && c <= '9':
+		case c == '.' && point == -1 && i > 0 && i < len(digits)-1:
+			point = i
+		default:
+			return Decimal{}, false
+		}
+	}
+	if digits == "" {
+		return Decimal{}, false
+	}
+
+	var d Decimal
+	if point != -1 {
+		frac := len(digits) - point - 1
+		if frac > math.MaxInt32 {
+			return Decimal{}, false
+		}
+		d.Exp = -int32(frac)
+		digits = digits[:point] + digits[point+1:]
+	}
+	d.Coef, _ = new(big.Int).SetString(digits, 10)
+	if s[0] == '-' {
+		d.Coef.Neg(d.Coef)
+	}
+	return d, true
+}
+
+// currencies contains active ISO 4217 codes of currencies, funds and precious
+// metals. Withdrawn codes, bond market units (XBA-XBD), code for testing
+// (XTS) and code for transactions without currency (XXX) are not included
+var currencies = map[string]struct{}{}
+
+func init() {
+	for _, code := range [...]string{
+		"AED", "AFN", "ALL", "AMD", "AOA", "ARS", "AUD", "AWG", "AZN", "BAM",
+		"BBD", "BDT", "BGN", "BHD", "BIF", "BMD", "BND", "BOB", "BOV", "BRL",
+		"BSD", "BTN", "BWP", "BYN", "BZD", "CAD", "CDF", "CHE", "CHF", "CHW",
+		"CLF", "CLP", "CNY", "COP", "COU", "CRC", "CUP", "CVE", "CZK", "DJF",
+		"DKK", "DOP", "DZD", "EGP", "ERN", "ETB", "EUR", "FJD", "FKP", "GBP",
+		"GEL", "GHS", "GIP", "GMD", "GNF", "GTQ", "GYD", "HKD", "HNL", "HTG",
+		"HUF", "IDR", "ILS", "INR", "IQD", "IRR", "ISK", "JMD", "JOD", "JPY",
+		"KES", "KGS", "KHR", "KMF", "KPW", "KRW", "KWD", "KYD", "KZT", "LAK",
+		"LBP", "LKR", "LRD", "LSL", "LYD", "MAD", "MDL", "MGA", "MKD", "MMK",
+		"MNT", "MOP", "MRU", "MUR", "MVR", "MWK", "MXN", "MXV", "MYR", "MZN",
+		"NAD", "NGN", "NIO", "NOK", "NPR", "NZD", "OMR", "PAB", "PEN", "PGK",
+		"PHP", "PKR", "PLN", "PYG", "QAR", "RON", "RSD", "RUB", "RWF", "SAR",
+		"SBD", "SCR", "SDG", "SEK", "SGD", "SHP", "SLE", "SOS", "SRD", "SSP",
+		"STN", "SVC", "SYP", "SZL", "THB", "TJS", "TMT", "TND", "TOP", "TRY",
+		"TTD", "TWD", "TZS", "UAH", "UGX", "USD", "USN", "UYI", "UYU", "UYW",
+		"UZS", "VED", "VES", "VND", "VUV", "WST", "XAF", "XAG", "XAU", "XCD",
+		"XCG", "XDR", "XOF", "XPD", "XPF", "XPT", "XSU", "XUA", "YER", "ZAR",
+		"ZMW", "ZWG",
+	} {
+		currencies[code] = struct{}{}
+	}
+}
+
+// IsCurrency reports whether code is a known ISO 4217 code, codes are case
+// sensitive
+func IsCurrency(code string) bool {
+	_, ok := currencies[code]
+	return ok
+}
+
+// Currencies returns sorted list of known ISO 4217 codes
+func Currencies() []string {
+	codes := make([]string, 0, len(currencies))
+	for code := range currencies {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// parseCurrencies converts args of currency rule to allowed codes: nil for
+// "ISO4217", which allows any known code, or list of known codes
+func parseCurrencies(rawArgs string) ([]string, bool) {
+	codes, ok := splitArgs(rawArgs)
+	if !ok {
+		return nil, false
+	}
+	if len(codes) == 1 && codes[0] == "ISO4217" {
+		return nil, true
+	}
+	for _, code := range codes {
+		if !IsCurrency(code) {
+			return nil, false
+		}
+	}
+	return codes, true
+}
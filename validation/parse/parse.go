@@ -1,8 +1,13 @@
 package parse
 
 import (
+	"math"
+	"regexp"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
+	"unicode"
 )
 
 type ValidationOperation int
@@ -14,42 +19,324 @@ const (
 	Min
 	Max
 	LenInterval
+	Regexp
+	Email
+	URL
+	UUID
+	Phone
+	Alpha
+	Alnum
+	ASCII
+	Numeric
+	Hex
+	LenMode  // sets unit of length for the other rules of the tag
+	TimeZone // sets location of dates for the other rules of the tag
+	Before
+	After
+	Within
+	Weekday
+	Positive  // value of number or decimal is greater than zero
+	Scale     // number of fractional digits of decimal
+	Precision // number of significant digits of decimal
+	Currency  // ISO 4217 code of currency
+	DecMin    // exact lower bound of decimal
+	DecMax    // exact upper bound of decimal
+	Required  // value is present: not nil, empty or zero
+	OmitEmpty // the other rules are skipped for absent value
+	NonZero   // value is present and pointers don't point to zero value
+	EqField
+	NeField
+	GtField
+	GteField
+	LtField
+	LteField
+	RequiredIf
+	Dive    // following rules are applied to elements of collection
+	Keys    // following rules up to EndKeys are applied to keys of map
+	EndKeys // end of rules for keys of map
+
+	firstCustom // operations added by RegisterOperation start from this value
+)
+
+// builtinNames contains names of operations which can't be registered
+var builtinNames = map[string]struct{}{
+	"len":         {},
+	"in":          {},
+	"min":         {},
+	"max":         {},
+	"lenInterval": {},
+	"regexp":      {},
+	"email":       {},
+	"url":         {},
+	"uuid":        {},
+	"phone":       {},
+	"alpha":       {},
+	"alnum":       {},
+	"ascii":       {},
+	"numeric":     {},
+	"hex":         {},
+	"lenmode":     {},
+	"tz":          {},
+	"before":      {},
+	"after":       {},
+	"within":      {},
+	"weekday":     {},
+	"positive":    {},
+	"scale":       {},
+	"precision":   {},
+	"currency":    {},
+	"decmin":      {},
+	"decmax":      {},
+	"required":    {},
+	"omitempty":   {},
+	"nonzero":     {},
+	"eqfield":     {},
+	"nefield":     {},
+	"gtfield":     {},
+	"gtefield":    {},
+	"ltfield":     {},
+	"ltefield":    {},
+	"required_if": {},
+	"dive":        {},
+	"keys":        {},
+	"endkeys":     {},
+}
+
+// markerOperations contains operations which split rules of the tag into
+// groups and have no args
+var markerOperations = map[string]ValidationOperation{
+	"dive":    Dive,
+	"keys":    Keys,
+	"endkeys": EndKeys,
+}
+
+// presenceOperations contains operations which check whether value is
+// present and have no args
+var presenceOperations = map[string]ValidationOperation{
+	"required":  Required,
+	"omitempty": OmitEmpty,
+	"nonzero":   NonZero,
+}
+
+// IsPresence reports whether v checks presence of value instead of the value
+// itself, such rules are applied to pointers and collections as a whole
+func IsPresence(v ValidationOperation) bool {
+	return v == Required || v == OmitEmpty || v == NonZero
+}
+
+// LengthUnit is a unit in which length of string is measured
+type LengthUnit int
+
+const (
+	Runes     LengthUnit = iota // Unicode code points, default unit
+	Bytes                       // bytes of UTF-8 encoding
+	Graphemes                   // user-perceived characters, e.g. emoji with modifiers
 )
 
-// ValidationParams decomposes validate tag to type of operation and args
-func ValidationParams(validateTag string) (v ValidationOperation, args any) {
-	temp := strings.Split(validateTag, ":")
-	if len(temp) <= 1 {
+var lengthUnits = map[string]LengthUnit{
+	"runes":     Runes,
+	"bytes":     Bytes,
+	"graphemes": Graphemes,
+}
+
+// formatOperations contains operations which check format of string and
+// have no args
+var formatOperations = map[string]ValidationOperation{
+	"email":   Email,
+	"url":     URL,
+	"uuid":    UUID,
+	"phone":   Phone,
+	"alpha":   Alpha,
+	"alnum":   Alnum,
+	"ascii":   ASCII,
+	"numeric": Numeric,
+	"hex":     Hex,
+}
+
+// regexps caches compiled patterns of regexp operation
+var regexps sync.Map
+
+// Rule is a single operation of validate tag with its args
+type Rule struct {
+	Op       ValidationOperation
+	Name     string   // name of operation as it is written in tag
+	Params   []string // args as they are written in tag, without quotes
+	Args     any      // args converted to the type operation expects
+	Unit     LengthUnit
+	Location *time.Location // location of dates without offset, UTC by default
+}
+
+// ValidationRules decomposes validate tag to the list of rules. Rules are
+// separated by ';' or spaces, e.g. "min:3;max:20" or "min:3 max:20". Values
+// containing separators, commas or colons may be put in single quotes:
+// "in:'a,b','c:d'"
+func ValidationRules(validateTag string) []Rule {
+	tokens, ok := splitUnquoted(validateTag, isRuleSeparator)
+	if !ok {
+		return []Rule{{Op: Wrong, Name: validateTag}}
+	}
+
+	rules := make([]Rule, 0, len(tokens))
+	for _, token := range tokens {
+		if token == "" { // several separators in a row
+			continue
+		}
+		v, args := ValidationParams(token)
+		name, rawArgs, _ := cutUnquoted(token, ':')
+		var params []string
+		if v == Regexp { // commas are a part of the pattern
+			params = []string{unquote(rawArgs)}
+		} else if rawArgs != "" {
+			params, _ = splitArgs(rawArgs)
+		}
+		rules = append(rules, Rule{Op: v, Name: name, Params: params, Args: args})
+	}
+	if len(rules) == 0 {
+		return []Rule{{Op: Wrong}}
+	}
+	setLengthUnit(rules)
+	setLocation(rules)
+	checkOmitEmpty(rules)
+	return rules
+}
+
+// checkOmitEmpty makes omitempty invalid if the same value is required to be
+// present by required, nonzero or required_if. Rules for the value, its keys and its
+// elements are checked separately
+func checkOmitEmpty(rules []Rule) {
+	start := 0
+	for i := 0; i <= len(rules); i++ {
+		if i < len(rules) && rules[i].Op != Dive && rules[i].Op != Keys && rules[i].Op != EndKeys {
+			continue
+		}
+		required := false
+		for _, rule := range rules[start:i] {
+			required = required || rule.Op == Required || rule.Op == NonZero || rule.Op == RequiredIf
+		}
+		for j := start; j < i && required; j++ {
+			if rules[j].Op == OmitEmpty {
+				rules[j].Op = Wrong
+			}
+		}
+		start = i + 1
+	}
+}
+
+// setLengthUnit applies lenmode rule to all rules of the tag. Tag with several
+// lenmode rules is invalid
+func setLengthUnit(rules []Rule) {
+	var unit LengthUnit
+	found := false
+	for i := range rules {
+		if rules[i].Op != LenMode {
+			continue
+		}
+		if found {
+			rules[i].Op = Wrong
+			continue
+		}
+		unit, found = rules[i].Args.(LengthUnit), true
+	}
+	for i := range rules {
+		rules[i].Unit = unit
+	}
+}
+
+// ValidationParams decomposes single rule of validate tag to type of
+// operation and args
+func ValidationParams(rule string) (v ValidationOperation, args any) {
+	name, rawArgs, found := cutUnquoted(rule, ':')
+
+	// args of registered operations are passed to them as they are
+	if op, ok := customOperation(name); ok {
+		if !found {
+			return op, []string(nil)
+		}
+		if args, ok := splitArgs(rawArgs); ok {
+			return op, args
+		}
+		return Wrong, nil
+	}
+
+	if op, ok := markerOperations[name]; ok {
+		if found {
+			return Wrong, nil
+		}
+		return op, nil
+	}
+
+	if op, ok := presenceOperations[name]; ok {
+		if found {
+			return Wrong, nil
+		}
+		return op, nil
+	}
+
+	if op, ok := formatOperations[name]; ok {
+		if found {
+			return Wrong, nil
+		}
+		return op, nil
+	}
+
+	if name == "weekday" {
+		if !found {
+			return Weekday, Workdays
+		}
+		if days, ok := parseWeekdays(rawArgs); ok {
+			return Weekday, days
+		}
+		return Wrong, nil
+	}
+
+	if name == "positive" {
+		if found {
+			return Wrong, nil
+		}
+		return Positive, nil
+	}
+
+	if name == "currency" {
+		if !found {
+			return Currency, []string(nil)
+		}
+		if codes, ok := parseCurrencies(rawArgs); ok {
+			return Currency, codes
+		}
+		return Wrong, nil
+	}
+
+	if !found {
 		return Wrong, nil
 	}
-	switch temp[0] {
+	switch name {
 	case "len":
-		if n, err := strconv.Atoi(temp[1]); err != nil {
+		if n, err := strconv.Atoi(unquote(rawArgs)); err != nil {
 			return Wrong, nil
 		} else {
 			return Length, n
 		}
 	case "in":
-		args = strings.Split(temp[1], ",")
-		if args.([]string)[0] == "" {
+		values, ok := splitArgs(rawArgs)
+		if !ok || values[0] == "" {
 			return Wrong, nil
 		}
-		return In, args
+		return In, values
 	case "min":
-		if n, err := strconv.Atoi(temp[1]); err != nil {
+		if n, ok := parseBound(unquote(rawArgs)); !ok {
 			return Wrong, nil
 		} else {
 			return Min, n
 		}
 	case "max":
-		if n, err := strconv.Atoi(temp[1]); err != nil {
+		if n, ok := parseBound(unquote(rawArgs)); !ok {
 			return Wrong, nil
 		} else {
 			return Max, n
 		}
 	case "lenInterval":
-		numsInStrings := strings.Split(temp[1], ",")
-		if len(numsInStrings) != 2 {
+		numsInStrings, ok := splitArgs(rawArgs)
+		if !ok || len(numsInStrings) != 2 {
 			return Wrong, nil
 		}
 		var res [2]int
@@ -64,7 +351,219 @@ func ValidationParams(validateTag string) (v ValidationOperation, args any) {
 			res[1] = n
 		}
 		return LenInterval, res
+	case "lenmode":
+		if unit, ok := lengthUnits[unquote(rawArgs)]; !ok {
+			return Wrong, nil
+		} else {
+			return LenMode, unit
+		}
+	case "tz":
+		if loc, err := time.LoadLocation(unquote(rawArgs)); err != nil || unquote(rawArgs) == "" {
+			return Wrong, nil
+		} else {
+			return TimeZone, loc
+		}
+	case "before", "after":
+		m, ok := parseMoment(unquote(rawArgs))
+		if !ok {
+			return Wrong, nil
+		}
+		if name == "before" {
+			return Before, m
+		}
+		return After, m
+	case "within":
+		if d, err := time.ParseDuration(unquote(rawArgs)); err != nil || d <= 0 {
+			return Wrong, nil
+		} else {
+			return Within, d
+		}
+	case "scale", "precision":
+		n, err := strconv.Atoi(unquote(rawArgs))
+		if err != nil || n < 0 || name == "precision" && n == 0 {
+			return Wrong, nil
+		}
+		if name == "scale" {
+			return Scale, n
+		}
+		return Precision, n
+	case "decmin", "decmax":
+		d, ok := ParseDecimal(unquote(rawArgs))
+		if !ok {
+			return Wrong, nil
+		}
+		if name == "decmin" {
+			return DecMin, d
+		}
+		return DecMax, d
+	case "eqfield", "nefield", "gtfield", "gtefield", "ltfield", "ltefield":
+		if ref, ok := parseFieldRef(unquote(rawArgs)); !ok {
+			return Wrong, nil
+		} else {
+			return compareOperations[name], ref
+		}
+	case "required_if":
+		if cond, ok := parseCondition(rawArgs); !ok {
+			return Wrong, nil
+		} else {
+			return RequiredIf, cond
+		}
+	case "regexp":
+		if re, ok := compileRegexp(unquote(rawArgs)); !ok {
+			return Wrong, nil
+		} else {
+			return Regexp, re
+		}
 	default:
 		return Wrong, nil
 	}
 }
+
+// compileRegexp returns compiled pattern from cache or compiles and caches it
+func compileRegexp(pattern string) (*regexp.Regexp, bool) {
+	if re, ok := regexps.Load(pattern); ok {
+		return re.(*regexp.Regexp), re.(*regexp.Regexp) != nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		re = nil // invalid patterns are cached too
+	}
+	regexps.Store(pattern, re)
+	return re, re != nil
+}
+
+// parseNumber converts arg of validate tag to int if possible or to float64
+// otherwise
+func parseNumber(s string) (any, bool) {
+	if n, err := strconv.Atoi(s); err == nil {
+		return n, true
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, false
+	}
+	return f, true
+}
+
+// parseBound converts arg of min and max rules to number or to time.Duration
+// if it is written like 1h30m
+func parseBound(s string) (any, bool) {
+	if n, ok := parseNumber(s); ok {
+		return n, true
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return d, true
+	}
+	return nil, false
+}
+
+func isRuleSeparator(r rune) bool {
+	return r == ';' || unicode.IsSpace(r)
+}
+
+// splitArgs splits args of rule by commas and removes quotes from them
+func splitArgs(rawArgs string) ([]string, bool) {
+	args, ok := splitUnquoted(rawArgs, func(r rune) bool { return r == ',' })
+	if !ok {
+		return nil, false
+	}
+	for i := range args {
+		args[i] = unquote(args[i])
+	}
+	return args, true
+}
+
+// splitUnquoted splits s by runes matching isSep which are not enclosed in
+// single quotes. Inside quotes backslash escapes the next rune. Returns false
+// if s contains unterminated quote
+func splitUnquoted(s string, isSep func(r rune) bool) ([]string, bool) {
+	var res []string
+	var cur strings.Builder
+	inQuotes, escaped := false, false
+
+	for _, r := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case inQuotes && r == '\\':
+			escaped = true
+		case r == '\'':
+			inQuotes = !inQuotes
+		case !inQuotes && isSep(r):
+			res = append(res, cur.String())
+			cur.Reset()
+			continue
+		}
+		cur.WriteRune(r)
+	}
+
+	if inQuotes || escaped {
+		return nil, false
+	}
+	return append(res, cur.String()), true
+}
+
+// cutUnquoted slices s around the first sep which is not enclosed in single
+// quotes
+func cutUnquoted(s string, sep rune) (before, after string, found bool) {
+	inQuotes, escaped := false, false
+	for i, r := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case inQuotes && r == '\\':
+			escaped = true
+		case r == '\'':
+			inQuotes = !inQuotes
+		case !inQuotes && r == sep:
+			return s[:i], s[i+1:], true
+		}
+	}
+	return s, "", false
+}
+
+// unquote removes single quotes around s and unescapes quotes inside them.
+// Other backslashes are kept as they are, so patterns like '^\d+$' keep
+// their escapes
+func unquote(s string) string {
+	if len(s) < 2 || s[0] != '\'' || s[len(s)-1] != '\'' {
+		return s
+	}
+	return strings.ReplaceAll(s[1:len(s)-1], `\'`, "'")
+}
+
+// SplitDive splits rules of the tag by the first dive rule: self are applied
+// to the value itself, keys and elems are applied to keys and elements of
+// collection. Rules for keys must be enclosed in keys and endkeys right after
+// dive. Misplaced keys and endkeys rules are returned as invalid
+func SplitDive(rules []Rule) (self, keys, elems []Rule, dive bool, invalid []Rule) {
+	for i, rule := range rules {
+		if rule.Op == Dive {
+			elems, dive = rules[i+1:], true
+			break
+		}
+		if rule.Op == Keys || rule.Op == EndKeys {
+			invalid = append(invalid, wrong(rule))
+			continue
+		}
+		self = append(self, rule)
+	}
+	if !dive || len(elems) == 0 || elems[0].Op != Keys {
+		return self, nil, elems, dive, invalid
+	}
+
+	for i, rule := range elems[1:] {
+		if rule.Op == EndKeys {
+			return self, elems[1 : i+1], elems[i+2:], true, invalid
+		}
+	}
+
+	// keys without endkeys
+	return self, nil, nil, true, append(invalid, wrong(elems[0]))
+}
+
+// wrong returns copy of rule which is considered invalid
+func wrong(rule Rule) Rule {
+	rule.Op = Wrong
+	return rule
+}
@@ -0,0 +1,106 @@
+package parse
+
+import (
+	"strings"
+	"time"
+)
+
+// Moment is an arg of before and after rules: the current time shifted by
+// duration or a date, e.g. "now", "now-720h", "2020-01-01" or
+// "2020-01-01T10:00:00+03:00"
+type Moment struct {
+	Now   bool
+	Shift time.Duration // added to the current time
+	Time  time.Time     // date, considered to be in Rule.Location unless Zoned
+	Zoned bool          // date has explicit offset
+}
+
+// At returns time m refers to if the current time is now, dates without
+// offset are considered to be in loc
+func (m Moment) At(now time.Time, loc *time.Location) time.Time {
+	switch {
+	case m.Now:
+		return now.Add(m.Shift)
+	case m.Zoned:
+		return m.Time
+	default:
+		t := m.Time
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+	}
+}
+
+// dateLayouts are layouts of dates without offset
+var dateLayouts = []string{"2006-01-02", "2006-01-02T15:04:05", "2006-01-02 15:04:05"}
+
+func parseMoment(s string) (Moment, bool) {
+	if s == "now" {
+		return Moment{Now: true}, true
+	}
+	if strings.HasPrefix(s, "now+") || strings.HasPrefix(s, "now-") {
+		shift, err := time.ParseDuration(strings.TrimPrefix(s, "now"))
+		if err != nil {
+			return Moment{}, false
+		}
+		return Moment{Now: true, Shift: shift}, true
+	}
+
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return Moment{Time: t, Zoned: true}, true
+	}
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return Moment{Time: t}, true
+		}
+	}
+	return Moment{}, false
+}
+
+// Workdays are days checked by weekday rule without args
+var Workdays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "sunday": time.Sunday,
+	"mon": time.Monday, "monday": time.Monday,
+	"tue": time.Tuesday, "tuesday": time.Tuesday,
+	"wed": time.Wednesday, "wednesday": time.Wednesday,
+	"thu": time.Thursday, "thursday": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday,
+}
+
+// parseWeekdays converts args of weekday rule like "sat,sun" to days
+func parseWeekdays(rawArgs string) ([]time.Weekday, bool) {
+	names, ok := splitArgs(rawArgs)
+	if !ok {
+		return nil, false
+	}
+	days := make([]time.Weekday, 0, len(names))
+	for _, name := range names {
+		day, ok := weekdays[strings.ToLower(name)]
+		if !ok {
+			return nil, false
+		}
+		days = append(days, day)
+	}
+	return days, true
+}
+
+// setLocation applies tz rule to all rules of the tag. Tag with several tz
+// rules is invalid
+func setLocation(rules []Rule) {
+	loc := time.UTC
+	found := false
+	for i := range rules {
+		if rules[i].Op != TimeZone {
+			continue
+		}
+		if found {
+			rules[i].Op = Wrong
+			continue
+		}
+		loc, found = rules[i].Args.(*time.Location), true
+	}
+	for i := range rules {
+		rules[i].Location = loc
+	}
+}
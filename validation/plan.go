@@ -0,0 +1,324 @@
+package go_course_validation
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	"github.com/papey08/golang-fintech/validation/internal/typeutil"
+	"github.com/papey08/golang-fintech/validation/parse"
+)
+
+// structPlan is a compiled description of how values of struct type are
+// validated, so tags are parsed only once per type
+type structPlan struct {
+	fields []fieldPlan
+	hook   hookKind // how Validate method of the struct is called
+}
+
+// fieldPlan describes validation of a single field of struct
+type fieldPlan struct {
+	index      int
+	name       string
+	unexported bool         // field is unexported but has validate tag
+	refs       [][]int      // indices of fields referred by cross-field rules
+	invalid    []parse.Rule // invalid rules for keys and elements of the field
+	groups     []string     // rules of the field are checked only if one of groups is active
+	inactive   *valuePlan   // plan of nested structs used when groups are not active
+	valuePlan               // rules of the field may be invalid or cross-field
+}
+
+// active reports whether rules of the field are checked when groups are
+// active, fields without groups are always checked
+func (fp *fieldPlan) active(groups []string) bool {
+	return len(fp.groups) == 0 || parse.InGroups(fp.groups, groups)
+}
+
+// valuePlan describes validation of value of field or of element of collection
+type valuePlan struct {
+	rules      []parse.Rule // rules applied to the value itself
+	label      string       // name of the field for messages, see Localize
+	collection bool         // rules restrict collection itself instead of its elements
+	omitEmpty  bool         // rules and content are skipped if value is empty
+	keys       *valuePlan   // plan of keys of map
+	elems      *valuePlan   // plan of elements of collection
+	nested     *structPlan  // plan of struct value
+}
+
+// hasContent reports whether value has fields, keys or elements to check
+func (vp *valuePlan) hasContent() bool {
+	return vp.nested != nil || vp.keys != nil || vp.elems != nil
+}
+
+// plans caches *structPlan by reflect.Type
+var plans sync.Map
+
+// planMu serializes building of plans, so recursive types are built once
+var planMu sync.Mutex
+
+// planOf returns cached plan of struct type t or builds it
+func planOf(t reflect.Type) *structPlan {
+	if p, ok := plans.Load(t); ok {
+		return p.(*structPlan)
+	}
+
+	planMu.Lock()
+	defer planMu.Unlock()
+
+	building := make(map[reflect.Type]*structPlan)
+	p := buildPlan(t, building)
+
+	// plans are published only when all of them are completely built
+	for bt, bp := range building {
+		plans.Store(bt, bp)
+	}
+	return p
+}
+
+// buildPlan builds plan of struct type t and plans of its nested types, which
+// are collected in building to handle recursive types
+func buildPlan(t reflect.Type, building map[reflect.Type]*structPlan) *structPlan {
+	if p, ok := plans.Load(t); ok {
+		return p.(*structPlan)
+	}
+	if p, ok := building[t]; ok {
+		return p
+	}
+
+	p := &structPlan{hook: hookOf(t)}
+	building[t] = p
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		validationTag, tagged := field.Tag.Lookup("validate")
+		label := field.Tag.Get("label")
+		groups := parse.Groups(field.Tag.Get("groups"))
+		if !field.IsExported() {
+			if tagged {
+				fp := fieldPlan{index: i, name: field.Name, unexported: true, groups: groups}
+				fp.label = label
+				p.fields = append(p.fields, fp)
+			}
+			continue
+		}
+
+		var rules []parse.Rule
+		if tagged {
+			rules = parse.ValidationRules(validationTag)
+		}
+
+		fp := fieldPlan{index: i, name: field.Name}
+		vp := buildValuePlan(field.Type, rules, building, &fp.invalid)
+		if vp == nil && len(fp.invalid) == 0 {
+			continue // nothing to check in untagged field
+		}
+		if vp != nil {
+			fp.valuePlan = *vp
+			fp.refs = resolveRefs(t, fp.rules)
+		}
+		setLabel(&fp.valuePlan, label)
+
+		// nested structs have their own groups, so they are checked even if
+		// groups of the field are not active
+		if tagged && len(groups) != 0 {
+			fp.groups = groups
+			var ignored []parse.Rule
+			fp.inactive = buildValuePlan(field.Type, nil, building, &ignored)
+			setLabel(fp.inactive, label)
+		}
+		p.fields = append(p.fields, fp)
+	}
+
+	return p
+}
+
+// buildValuePlan builds plan of value of type t checked by rules. Rules after
+// dive are applied to elements of collection, structs are validated according
+// to their own plans even without tags. Invalid rules for keys and elements
+// are collected to invalid. Returns nil if there is nothing to check
+func buildValuePlan(t reflect.Type, rules []parse.Rule, building map[reflect.Type]*structPlan, invalid *[]parse.Rule) *valuePlan {
+	self, keys, elems, dive, misplaced := parse.SplitDive(rules)
+	*invalid = append(*invalid, misplaced...)
+
+	t = typeutil.Deref(t)
+	isCollection := t.Kind() == reflect.Slice || t.Kind() == reflect.Array || t.Kind() == reflect.Map
+
+	vp := &valuePlan{
+		rules:      self,
+		collection: dive || t.Kind() == reflect.Map,
+	}
+	for _, rule := range self {
+		vp.omitEmpty = vp.omitEmpty || rule.Op == parse.OmitEmpty
+	}
+
+	if t.Kind() == reflect.Struct {
+		vp.nested = buildPlan(t, building)
+	}
+
+	switch {
+	case dive && !isCollection:
+		*invalid = append(*invalid, parse.Rule{Op: parse.Wrong, Name: "dive"})
+	case dive:
+		vp.elems = buildValuePlan(t.Elem(), elemRules(elems, invalid), building, invalid)
+		if keys != nil && t.Kind() != reflect.Map {
+			*invalid = append(*invalid, parse.Rule{Op: parse.Wrong, Name: "keys"})
+		} else if keys != nil {
+			vp.keys = buildValuePlan(t.Key(), elemRules(keys, invalid), building, invalid)
+		}
+	case isCollection && containsStruct(t.Elem(), make(map[reflect.Type]bool)):
+		// structs in collections are validated without dive
+		vp.elems = buildValuePlan(t.Elem(), nil, building, invalid)
+	}
+
+	if len(vp.rules) == 0 && vp.nested == nil && vp.elems == nil && vp.keys == nil {
+		return nil
+	}
+	return vp
+}
+
+// setLabel sets label of field to plans of its keys and elements, fields of
+// nested structs have their own labels
+func setLabel(vp *valuePlan, label string) {
+	if vp == nil {
+		return
+	}
+	vp.label = label
+	setLabel(vp.keys, label)
+	setLabel(vp.elems, label)
+}
+
+// containsStruct reports whether values of type t may contain structs, seen
+// holds collection types already looked at, e.g. for type L []L
+func containsStruct(t reflect.Type, seen map[reflect.Type]bool) bool {
+	t = typeutil.Deref(t)
+	switch t.Kind() {
+	case reflect.Struct:
+		return true
+	case reflect.Slice, reflect.Array, reflect.Map:
+		if seen[t] {
+			return false
+		}
+		seen[t] = true
+		return containsStruct(t.Elem(), seen)
+	default:
+		return false
+	}
+}
+
+// elemRules moves invalid and cross-field rules, which are not supported for
+// keys and elements of collections, to invalid
+func elemRules(rules []parse.Rule, invalid *[]parse.Rule) []parse.Rule {
+	var res []parse.Rule
+	for _, rule := range rules {
+		if rule.Op == parse.Wrong || parse.IsCrossField(rule.Op) {
+			*invalid = append(*invalid, parse.Rule{Op: parse.Wrong, Name: rule.Name, Params: rule.Params})
+			continue
+		}
+		res = append(res, rule)
+	}
+	return res
+}
+
+// resolveRefs finds indices of fields referred by cross-field rules of struct
+// type t. Rules referring to unknown or unexported fields become invalid
+func resolveRefs(t reflect.Type, rules []parse.Rule) [][]int {
+	var refs [][]int
+	for i, rule := range rules {
+		if !parse.IsCrossField(rule.Op) {
+			continue
+		}
+
+		var ref parse.FieldRef
+		switch args := rule.Args.(type) {
+		case parse.FieldRef:
+			ref = args
+		case parse.Condition:
+			ref = args.Field
+		}
+
+		index, ok := resolveField(t, ref)
+		if !ok {
+			rules[i].Op = parse.Wrong
+			continue
+		}
+		if refs == nil {
+			refs = make([][]int, len(rules))
+		}
+		refs[i] = index
+	}
+	return refs
+}
+
+// resolveField returns index sequence of field with path ref in struct type t,
+// pointers to nested structs are followed
+func resolveField(t reflect.Type, ref parse.FieldRef) ([]int, bool) {
+	var index []int
+	for _, name := range ref {
+		t = typeutil.Deref(t)
+		if t.Kind() != reflect.Struct {
+			return nil, false
+		}
+		field, ok := t.FieldByName(name)
+		if !ok || !field.IsExported() {
+			return nil, false
+		}
+		index = append(index, field.Index...)
+		t = field.Type
+	}
+	return index, true
+}
+
+// resetPlans drops cached plans, e.g. when tags may be parsed differently
+// after registering new rule
+func resetPlans() {
+	plans.Range(func(key, _ any) bool {
+		plans.Delete(key)
+		return true
+	})
+}
+
+// Validator validates values of struct type T with plan compiled in advance
+type Validator[T any] struct {
+	plan *structPlan
+}
+
+// Compile parses validate tags of struct type T and its nested structs once,
+// so returned Validator doesn't look at tags anymore. Custom rules should be
+// registered before Compile. T may be a struct or a pointer to struct, returns
+// ErrNotStruct otherwise
+func Compile[T any]() (*Validator[T], error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, ErrNotStruct
+	}
+	return &Validator[T]{plan: planOf(t)}, nil
+}
+
+// Validate checks fields of v the same way as package-level Validate does
+func (vr *Validator[T]) Validate(v T) error {
+	return vr.ValidateCtx(context.Background(), v)
+}
+
+// ValidateCtx checks fields of v the same way as package-level ValidateCtx
+// does
+func (vr *Validator[T]) ValidateCtx(ctx context.Context, v T) error {
+	rv, err := structValue(reflect.ValueOf(v))
+	if err != nil {
+		return err
+	}
+	return validateRoot(rv, vr.plan, options{ctx: ctx})
+}
+
+// ValidateWith checks fields of v the same way as package-level ValidateWith
+// does
+func (vr *Validator[T]) ValidateWith(v T, opts ...Option) error {
+	rv, err := structValue(reflect.ValueOf(v))
+	if err != nil {
+		return err
+	}
+	return validateRoot(rv, vr.plan, newOptions(opts))
+}
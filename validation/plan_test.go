@@ -0,0 +1,145 @@
+package go_course_validation
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/papey08/golang-fintech/validation/check"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type BenchAd struct {
+	ID       int64    `validate:"min:0"`
+	Title    string   `validate:"min:1;max:99"`
+	Text     string   `validate:"lenInterval:1,499"`
+	Status   string   `validate:"in:draft,published,archived"`
+	Email    string   `validate:"email"`
+	Tags     []string `validate:"max:20"`
+	AuthorID int64    `validate:"min:1"`
+	Item     Item
+}
+
+// Tree is a recursive type for testing plans
+type Tree struct {
+	Value    int `validate:"min:0"`
+	Children []Tree
+}
+
+var benchAd = BenchAd{
+	ID:       1,
+	Title:    "Продам гараж",
+	Text:     "Гараж в хорошем состоянии",
+	Status:   "published",
+	Email:    "seller@example.com",
+	Tags:     []string{"гараж", "недвижимость"},
+	AuthorID: 2,
+	Item:     Item{Name: "garage", Price: 100},
+}
+
+func TestCompile(t *testing.T) {
+	_, err := Compile[int]()
+	assert.ErrorIs(t, err, ErrNotStruct)
+
+	v, err := Compile[BenchAd]()
+	assert.NoError(t, err)
+	assert.NoError(t, v.Validate(benchAd))
+
+	invalid := benchAd
+	invalid.Title = ""
+	invalid.Item.Price = -1
+	err = v.Validate(invalid)
+	assert.ErrorIs(t, err, check.ErrInvalidFieldValue)
+	assert.Len(t, err.(ValidationErrors), 2)
+	assert.Equal(t, err, Validate(invalid))
+}
+
+func TestPlanOfRecursiveType(t *testing.T) {
+	tree := Tree{
+		Value: 1,
+		Children: []Tree{
+			{Value: 2},
+			{Value: 3, Children: []Tree{{Value: -4}}},
+		},
+	}
+	err := Validate(tree)
+	assert.ErrorIs(t, err, check.ErrInvalidFieldValue)
+	assert.Equal(t, "Children[1].Children[0].Value", err.(ValidationErrors)[0].Field)
+
+	p := planOf(reflect.TypeOf(tree))
+	assert.Same(t, p, p.fields[1].elems.nested)
+}
+
+func TestValidateConcurrently(t *testing.T) {
+	invalid := benchAd
+	invalid.Email = "seller"
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				assert.NoError(t, Validate(benchAd))
+				assert.Error(t, Validate(invalid))
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func BenchmarkValidate(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = Validate(benchAd)
+	}
+}
+
+func BenchmarkValidateCompiled(b *testing.B) {
+	v, _ := Compile[BenchAd]()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = v.Validate(benchAd)
+	}
+}
+
+// BenchmarkValidateWithoutCache parses tags on every call as Validate did
+// before plans were cached
+func BenchmarkValidateWithoutCache(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		resetPlans()
+		_ = Validate(benchAd)
+	}
+}
+
+type List []List
+
+type Node struct {
+	Value int `validate:"min:0"`
+	Next  *Node
+	Links []Node `validate:"dive"`
+	List  List   `validate:"dive"`
+}
+
+func TestValidateCycles(t *testing.T) {
+	node := &Node{Value: -1, List: List{{}, {{}}}}
+	node.Next = node
+	node.Links = []Node{{Value: 1}}
+	node.Links[0].Links = node.Links
+
+	err := Validate(node)
+	assert.ErrorIs(t, err, check.ErrInvalidFieldValue)
+	assert.Len(t, err.(ValidationErrors), 2)
+	assert.Equal(t, "Value", err.(ValidationErrors)[0].Field)
+	assert.Equal(t, "Next.Value", err.(ValidationErrors)[1].Field)
+}
+
+func TestCompilePointer(t *testing.T) {
+	v, err := Compile[*BenchAd]()
+	assert.NoError(t, err)
+	assert.NoError(t, v.Validate(&benchAd))
+	assert.ErrorIs(t, v.Validate(nil), ErrNilValue)
+}
@@ -0,0 +1,275 @@
+package go_course_validation
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	"github.com/papey08/golang-fintech/validation/internal/typeutil"
+	"github.com/papey08/golang-fintech/validation/mod"
+	"github.com/papey08/golang-fintech/validation/parse"
+
+	"github.com/pkg/errors"
+)
+
+var ErrNotPointer = errors.New("wrong argument given, should be a pointer to struct")
+
+// modPlan is a compiled description of how values of struct type are
+// sanitized according to mod tags
+type modPlan struct {
+	fields []modFieldPlan
+}
+
+// modFieldPlan describes sanitizing of a single field of struct
+type modFieldPlan struct {
+	index        int
+	name         string
+	unexported   bool // field is unexported but has mod tag
+	modValuePlan      // modifiers of the field
+}
+
+// modValuePlan describes sanitizing of value of field or of element of
+// collection
+type modValuePlan struct {
+	mods   []parse.Modifier // applied to the value itself
+	elems  *modValuePlan    // plan of elements of collection
+	nested *modPlan         // plan of struct value
+}
+
+// modPlans caches *modPlan by reflect.Type
+var modPlans sync.Map
+
+// modPlanOf returns cached plan of sanitizing of struct type t or builds it
+func modPlanOf(t reflect.Type) *modPlan {
+	if p, ok := modPlans.Load(t); ok {
+		return p.(*modPlan)
+	}
+
+	planMu.Lock()
+	defer planMu.Unlock()
+
+	building := make(map[reflect.Type]*modPlan)
+	p := buildModPlan(t, building)
+
+	// plans are published only when all of them are completely built
+	for bt, bp := range building {
+		modPlans.Store(bt, bp)
+	}
+	return p
+}
+
+// buildModPlan builds plan of sanitizing of struct type t and plans of its
+// nested types, which are collected in building to handle recursive types
+func buildModPlan(t reflect.Type, building map[reflect.Type]*modPlan) *modPlan {
+	if p, ok := modPlans.Load(t); ok {
+		return p.(*modPlan)
+	}
+	if p, ok := building[t]; ok {
+		return p
+	}
+
+	p := &modPlan{}
+	building[t] = p
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		modTag, tagged := field.Tag.Lookup("mod")
+		if !field.IsExported() {
+			if tagged {
+				p.fields = append(p.fields, modFieldPlan{index: i, name: field.Name, unexported: true})
+			}
+			continue
+		}
+
+		var mods []parse.Modifier
+		if tagged {
+			mods = parse.Modifiers(modTag)
+		}
+		if vp := buildModValuePlan(field.Type, mods, building, make(map[reflect.Type]bool)); vp != nil {
+			p.fields = append(p.fields, modFieldPlan{index: i, name: field.Name, modValuePlan: *vp})
+		}
+	}
+
+	return p
+}
+
+// buildModValuePlan builds plan of sanitizing of value of type t. Modifiers of
+// collection are applied to its elements, structs are sanitized according to
+// their own plans. Seen holds collection types on the current path, e.g. for
+// type L []L. Returns nil if there is nothing to modify
+func buildModValuePlan(t reflect.Type, mods []parse.Modifier, building map[reflect.Type]*modPlan, seen map[reflect.Type]bool) *modValuePlan {
+	t = typeutil.Deref(t)
+	vp := &modValuePlan{}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		vp.mods = mods
+		vp.nested = buildModPlan(t, building)
+		if len(vp.nested.fields) == 0 {
+			vp.nested = nil
+		}
+
+	case reflect.Slice, reflect.Array, reflect.Map:
+		if seen[t] {
+			return nil
+		}
+		seen[t] = true
+		vp.elems = buildModValuePlan(t.Elem(), mods, building, seen)
+
+	default:
+		vp.mods = mods
+	}
+
+	if len(vp.mods) == 0 && vp.nested == nil && vp.elems == nil {
+		return nil
+	}
+	return vp
+}
+
+// Sanitize modifies fields of struct ptr points to according to their mod
+// tags: trim, lower, upper, collapse_spaces, strip_html and default:value.
+// Modifiers of slices, arrays and maps are applied to their elements, nested
+// structs are sanitized too. Returns ValidationErrors for invalid tags and
+// modifiers which can't be applied to type of field
+func Sanitize(ptr any) error {
+	v := reflect.ValueOf(ptr)
+	if v.Kind() != reflect.Pointer {
+		if v.Kind() == reflect.Invalid {
+			return ErrNilValue
+		}
+		return ErrNotPointer
+	}
+	rv, err := structValue(v)
+	if err != nil {
+		return err
+	}
+
+	s := &validation{ctx: context.Background()}
+	if validationErrors := s.sanitizeStruct(rv, modPlanOf(rv.Type()), ""); len(validationErrors) != 0 {
+		return validationErrors
+	}
+	return nil
+}
+
+// SanitizeAndValidate sanitizes struct ptr points to and validates the result
+// if sanitizing succeeds
+func SanitizeAndValidate(ptr any) error {
+	if err := Sanitize(ptr); err != nil {
+		return err
+	}
+	return Validate(ptr)
+}
+
+// sanitizeStruct modifies fields of addressable struct value according to its
+// plan, path is a path to the struct from the sanitized one
+func (s *validation) sanitizeStruct(v reflect.Value, p *modPlan, path string) ValidationErrors {
+	var validationErrors ValidationErrors
+	for i := range p.fields {
+		field := &p.fields[i]
+		if field.unexported {
+			validationErrors = append(validationErrors, ValidationError{
+				Field: joinPath(path, field.name),
+				Err:   ErrValidateForUnexportedFields,
+			})
+			continue
+		}
+		validationErrors = append(validationErrors, s.sanitizeValue(v.Field(field.index), &field.modValuePlan, joinPath(path, field.name))...)
+	}
+	return validationErrors
+}
+
+// sanitizeValue modifies settable value with path according to its plan. Nil
+// pointers are allocated only for default modifier, values referring to
+// themselves are modified only once
+func (s *validation) sanitizeValue(v reflect.Value, vp *modValuePlan, path string) ValidationErrors {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			if !hasDefault(vp.mods) {
+				return nil
+			}
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		if !s.enter(v) {
+			return nil
+		}
+		defer s.leave(v)
+		return s.sanitizeValue(v.Elem(), vp, path)
+
+	case reflect.Struct:
+		validationErrors := s.applyModifiers(v, vp.mods, path)
+		if vp.nested != nil {
+			validationErrors = append(validationErrors, s.sanitizeStruct(v, vp.nested, path)...)
+		}
+		return validationErrors
+
+	case reflect.Slice, reflect.Array:
+		if vp.elems == nil {
+			return nil
+		}
+		var validationErrors ValidationErrors
+		for i := 0; i < v.Len(); i++ {
+			validationErrors = append(validationErrors, s.sanitizeValue(v.Index(i), vp.elems, indexPath(path, i))...)
+		}
+		return validationErrors
+
+	case reflect.Map:
+		if vp.elems == nil {
+			return nil
+		}
+		// elements of map are not addressable, so their copies are modified
+		var validationErrors ValidationErrors
+		for _, key := range sortedKeys(v) {
+			elem := reflect.New(v.Type().Elem()).Elem()
+			elem.Set(v.MapIndex(key))
+			validationErrors = append(validationErrors, s.sanitizeValue(elem, vp.elems, keyPath(path, key))...)
+			v.SetMapIndex(key, elem)
+		}
+		return validationErrors
+
+	case reflect.Interface:
+		return nil // value inside interface can't be modified
+
+	default:
+		return s.applyModifiers(v, vp.mods, path)
+	}
+}
+
+// applyModifiers applies mods to value with path in order
+func (s *validation) applyModifiers(v reflect.Value, mods []parse.Modifier, path string) ValidationErrors {
+	var validationErrors ValidationErrors
+	for _, m := range mods {
+		if m.Op == parse.WrongMod {
+			validationErrors = append(validationErrors, ValidationError{
+				Field: path,
+				Rule:  m.Name,
+				Err:   ErrInvalidValidatorSyntax,
+			})
+			continue
+		}
+		if err := mod.Apply(v, m); err != nil {
+			var args []string
+			if m.Op == parse.Default {
+				args = []string{m.Arg}
+			}
+			validationErrors = append(validationErrors, ValidationError{
+				Field: path,
+				Rule:  m.Name,
+				Args:  args,
+				Value: v.Interface(),
+				Err:   err,
+			})
+		}
+	}
+	return validationErrors
+}
+
+func hasDefault(mods []parse.Modifier) bool {
+	for _, m := range mods {
+		if m.Op == parse.Default {
+			return true
+		}
+	}
+	return false
+}
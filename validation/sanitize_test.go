@@ -0,0 +1,105 @@
+package go_course_validation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/papey08/golang-fintech/validation/check"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type Contact struct {
+	Email string  `mod:"trim;lower" validate:"email"`
+	Phone *string `mod:"trim"`
+}
+
+type AdRequest struct {
+	Title    Title             `mod:"strip_html collapse_spaces trim" validate:"min:1;max:20"`
+	Text     string            `mod:"strip_html;default:'Нет описания'"`
+	Currency string            `mod:"trim;upper;default:RUB" validate:"len:3"`
+	Tags     []string          `mod:"trim;lower"`
+	Params   map[string]string `mod:"trim"`
+	Limit    int               `mod:"default:20"`
+	Timeout  time.Duration     `mod:"default:1m30s"`
+	Public   *bool             `mod:"default:true"`
+	Contact  Contact
+	Contacts []*Contact
+	ByName   map[string]Contact
+}
+
+func TestSanitize(t *testing.T) {
+	phone := " +79991234567 "
+	req := AdRequest{
+		Title:    "  <b>Продам</b>\n\t гараж<script>alert(1)</script>  ",
+		Text:     "<p>Без <i>торга</i></p><!-- comment -->",
+		Tags:     []string{" Гараж ", "SALE"},
+		Params:   map[string]string{"area": " 20 "},
+		Limit:    5,
+		Contact:  Contact{Email: " Seller@Example.COM ", Phone: &phone},
+		Contacts: []*Contact{{Email: " A@B.RU"}, nil},
+		ByName:   map[string]Contact{"bob": {Email: "BOB@EXAMPLE.COM "}},
+	}
+
+	assert.NoError(t, Sanitize(&req))
+	assert.Equal(t, Title("Продам гараж"), req.Title)
+	assert.Equal(t, "Без торга", req.Text)
+	assert.Equal(t, "RUB", req.Currency)
+	assert.Equal(t, []string{"гараж", "sale"}, req.Tags)
+	assert.Equal(t, map[string]string{"area": "20"}, req.Params)
+	assert.Equal(t, 5, req.Limit)
+	assert.Equal(t, 90*time.Second, req.Timeout)
+	assert.True(t, *req.Public)
+	assert.Equal(t, "seller@example.com", req.Contact.Email)
+	assert.Equal(t, "+79991234567", phone)
+	assert.Equal(t, "a@b.ru", req.Contacts[0].Email)
+	assert.Nil(t, req.Contacts[1])
+	assert.Equal(t, "bob@example.com", req.ByName["bob"].Email)
+
+	// modifiers are applied in order, so text without tags gets default value
+	req.Text = "<br>"
+	assert.NoError(t, Sanitize(&req))
+	assert.Equal(t, "Нет описания", req.Text)
+}
+
+func TestSanitizeAndValidate(t *testing.T) {
+	req := AdRequest{Title: " <b></b> ", Currency: " usd "}
+	err := SanitizeAndValidate(&req)
+	var ve ValidationErrors
+	assert.True(t, errors.As(err, &ve))
+	assert.Len(t, ve, 2)
+	assert.Equal(t, "Title", ve[0].Field)
+	assert.Equal(t, "Contact.Email", ve[1].Field)
+	assert.Equal(t, "USD", req.Currency)
+
+	req = AdRequest{Title: "Гараж", Contact: Contact{Email: " Seller@Example.com"}}
+	assert.NoError(t, SanitizeAndValidate(&req))
+}
+
+func TestSanitizeErrors(t *testing.T) {
+	assert.ErrorIs(t, Sanitize(nil), ErrNilValue)
+	assert.ErrorIs(t, Sanitize((*AdRequest)(nil)), ErrNilValue)
+	assert.ErrorIs(t, Sanitize(AdRequest{}), ErrNotPointer)
+	assert.ErrorIs(t, Sanitize(new(int)), ErrNotStruct)
+
+	v := struct {
+		A int    `mod:"trim"`
+		B string `mod:"title"`
+		C int8   `mod:"default:1000"`
+		D string `mod:"default"`
+		E []int  `mod:"default:x"`
+		f string `mod:"trim"`
+	}{E: []int{0, 1}}
+	err := Sanitize(&v)
+	var ve ValidationErrors
+	assert.True(t, errors.As(err, &ve))
+	assert.Equal(t, []ValidationError{
+		{Field: "A", Rule: "trim", Value: 0, Err: check.ErrInvalidFieldType},
+		{Field: "B", Rule: "title", Err: ErrInvalidValidatorSyntax},
+		{Field: "C", Rule: "default", Args: []string{"1000"}, Value: int8(0), Err: check.ErrInvalidFieldType},
+		{Field: "D", Rule: "default", Err: ErrInvalidValidatorSyntax},
+		{Field: "E[0]", Rule: "default", Args: []string{"x"}, Value: 0, Err: check.ErrInvalidFieldType},
+		{Field: "f", Err: ErrValidateForUnexportedFields},
+	}, []ValidationError(ve))
+}
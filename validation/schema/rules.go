@@ -0,0 +1,299 @@
+package schema
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/papey08/golang-fintech/validation/parse"
+
+	"github.com/pkg/errors"
+)
+
+// formatPatterns contains patterns of format rules which have no format in
+// JSON Schema, they match the same strings as the checks of the rules
+var formatPatterns = map[parse.ValidationOperation]string{
+	parse.Phone:   `^\+[1-9][0-9]{1,14}$`,
+	parse.Alpha:   `^\p{L}+$`,
+	parse.Alnum:   `^[\p{L}\p{Nd}]+$`,
+	parse.ASCII:   `^[\x00-\x7F]*$`,
+	parse.Numeric: `^[0-9]+$`,
+	parse.Hex:     `^[0-9a-fA-F]+$`,
+}
+
+// decimalPattern matches strings checked by decimal rules
+const decimalPattern = `^[+-]?[0-9]+(\.[0-9]+)?$`
+
+// formats contains format rules which have format in JSON Schema
+var formats = map[parse.ValidationOperation]string{
+	parse.Email: "email",
+	parse.URL:   "uri",
+	parse.UUID:  "uuid",
+}
+
+// applyRules restricts schema s of basic type t with rules
+func applyRules(s *Schema, t reflect.Type, rules []parse.Rule) error {
+	for _, rule := range rules {
+		var err error
+		switch s.Type {
+		case "string":
+			err = applyStringRule(s, rule)
+		case "integer", "number":
+			err = applyNumberRule(s, t, rule)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "rule %s", rule.Name)
+		}
+	}
+	return nil
+}
+
+// applyStringRule restricts schema s of string with rule. Length is restricted
+// only if it is measured in runes, because JSON Schema counts code points
+func applyStringRule(s *Schema, rule parse.Rule) error {
+	lengths := rule.Unit == parse.Runes
+	switch args := rule.Args; rule.Op {
+	case parse.Length:
+		if lengths {
+			s.MinLength, s.MaxLength = intPtr(args.(int)), intPtr(args.(int))
+		}
+	case parse.Min:
+		if n, ok := args.(int); ok && lengths {
+			s.MinLength = intPtr(n)
+		}
+	case parse.Max:
+		if n, ok := args.(int); ok && lengths {
+			s.MaxLength = intPtr(n)
+		}
+	case parse.LenInterval:
+		if lengths {
+			s.MinLength, s.MaxLength = intPtr(args.([2]int)[0]), intPtr(args.([2]int)[1])
+		}
+	case parse.In:
+		s.Enum = nil
+		for _, arg := range args.([]string) {
+			s.Enum = append(s.Enum, arg)
+		}
+	case parse.Regexp:
+		// checks look for a match anywhere in string as JSON Schema does
+		addPattern(s, args.(*regexp.Regexp).String())
+	case parse.Email, parse.URL, parse.UUID:
+		s.Format = formats[rule.Op]
+	case parse.Phone, parse.Alpha, parse.Alnum, parse.ASCII, parse.Numeric, parse.Hex:
+		addPattern(s, formatPatterns[rule.Op])
+	case parse.Positive, parse.Precision, parse.DecMin, parse.DecMax:
+		// only format of decimal can be expressed for strings
+		addPattern(s, decimalPattern)
+	case parse.Scale:
+		addPattern(s, scalePattern(args.(int)))
+	case parse.Required, parse.NonZero:
+		if s.MinLength == nil || *s.MinLength < 1 {
+			s.MinLength = intPtr(1)
+		}
+	case parse.Currency:
+		codes := args.([]string)
+		if codes == nil {
+			codes = parse.Currencies()
+		}
+		s.Enum = nil
+		for _, code := range codes {
+			s.Enum = append(s.Enum, code)
+		}
+	}
+	return nil
+}
+
+// scalePattern matches decimals with at most n significant fractional digits
+func scalePattern(n int) string {
+	if n == 0 {
+		return `^[+-]?[0-9]+(\.0+)?$`
+	}
+	return `^[+-]?[0-9]+(\.[0-9]{1,` + strconv.Itoa(n) + `}0*)?$`
+}
+
+// applyNumberRule restricts schema s of number of type t with rule
+func applyNumberRule(s *Schema, t reflect.Type, rule parse.Rule) error {
+	switch args := rule.Args; rule.Op {
+	case parse.Min:
+		// negative bound doesn't restrict unsigned numbers
+		if n := number(args); s.Minimum == "" || compareNumbers(n, s.Minimum) > 0 {
+			s.Minimum = n
+		}
+	case parse.Max:
+		s.Maximum = number(args)
+	case parse.Positive:
+		if !isInteger(t) {
+			s.ExclusiveMinimum = "0"
+		} else if s.Minimum == "" || compareNumbers("1", s.Minimum) > 0 {
+			s.Minimum = "1"
+		}
+	case parse.DecMin:
+		if n := decimalNumber(rule.Params[0]); s.Minimum == "" || compareNumbers(n, s.Minimum) > 0 {
+			s.Minimum = n
+		}
+	case parse.DecMax:
+		s.Maximum = decimalNumber(rule.Params[0])
+	case parse.In:
+		s.Enum = nil
+		for _, arg := range args.([]string) {
+			n, err := convertNumber(arg, t)
+			if err != nil {
+				return err
+			}
+			s.Enum = append(s.Enum, n)
+		}
+	}
+	return nil
+}
+
+// applyCount restricts number of elements of collection with rules
+func applyCount(rules []parse.Rule, minCount, maxCount **int) {
+	for _, rule := range rules {
+		switch args := rule.Args; rule.Op {
+		case parse.Length:
+			*minCount, *maxCount = intPtr(args.(int)), intPtr(args.(int))
+		case parse.Min:
+			if n, ok := args.(int); ok {
+				*minCount = intPtr(n)
+			}
+		case parse.Max:
+			if n, ok := args.(int); ok {
+				*maxCount = intPtr(n)
+			}
+		case parse.LenInterval:
+			*minCount, *maxCount = intPtr(args.([2]int)[0]), intPtr(args.([2]int)[1])
+		case parse.Required, parse.NonZero:
+			if *minCount == nil || **minCount < 1 {
+				*minCount = intPtr(1)
+			}
+		}
+	}
+}
+
+func hasRule(rules []parse.Rule, op parse.ValidationOperation) bool {
+	for _, rule := range rules {
+		if rule.Op == op {
+			return true
+		}
+	}
+	return false
+}
+
+// withoutRule returns rules except the ones with op
+func withoutRule(rules []parse.Rule, op parse.ValidationOperation) []parse.Rule {
+	var res []parse.Rule
+	for _, rule := range rules {
+		if rule.Op != op {
+			res = append(res, rule)
+		}
+	}
+	return res
+}
+
+// emptySchema returns schema of empty value of type t which omitempty allows
+// or nil if it can't be described
+func emptySchema(t reflect.Type) *Schema {
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{Type: "string", MaxLength: intPtr(0)}
+	case reflect.Bool:
+		return &Schema{Enum: []any{false}}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return &Schema{Enum: []any{0}}
+	case reflect.Slice:
+		return &Schema{Type: "array", MaxItems: intPtr(0)}
+	case reflect.Map:
+		return &Schema{Type: "object", MaxProperties: intPtr(0)}
+	default:
+		return nil
+	}
+}
+
+// addPattern adds pattern to s, several patterns are combined with allOf
+func addPattern(s *Schema, pattern string) {
+	if s.Pattern == "" {
+		s.Pattern = pattern
+		return
+	}
+	s.AllOf = append(s.AllOf, &Schema{Pattern: pattern})
+}
+
+// number converts int, float64 or time.Duration arg of rule to JSON number,
+// durations are encoded in nanoseconds as encoding/json does
+func number(arg any) json.Number {
+	switch n := arg.(type) {
+	case int:
+		return json.Number(strconv.Itoa(n))
+	case time.Duration:
+		return json.Number(strconv.FormatInt(int64(n), 10))
+	default:
+		return json.Number(strconv.FormatFloat(n.(float64), 'g', -1, 64))
+	}
+}
+
+// decimalNumber converts arg of decmin or decmax rule to JSON number
+func decimalNumber(arg string) json.Number {
+	return json.Number(strings.TrimPrefix(arg, "+"))
+}
+
+func isInteger(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return false
+	}
+	return true
+}
+
+func compareNumbers(a, b json.Number) int {
+	x, _ := a.Float64()
+	y, _ := b.Float64()
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// convertNumber converts arg of in rule to number of type t the same way as
+// checks do
+func convertNumber(arg string, t reflect.Type) (any, error) {
+	if t == durationType {
+		if n, err := strconv.ParseInt(arg, 10, 64); err == nil {
+			return n, nil
+		}
+		d, err := time.ParseDuration(arg)
+		if err != nil {
+			return nil, ErrInvalidTag
+		}
+		return int64(d), nil
+	}
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(arg, 10, t.Bits())
+		if err != nil {
+			return nil, ErrInvalidTag
+		}
+		return n, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(arg, 10, t.Bits())
+		if err != nil {
+			return nil, ErrInvalidTag
+		}
+		return n, nil
+	default:
+		f, err := strconv.ParseFloat(arg, t.Bits())
+		if err != nil {
+			return nil, ErrInvalidTag
+		}
+		return f, nil
+	}
+}
@@ -0,0 +1,333 @@
+package schema
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/papey08/golang-fintech/validation/internal/typeutil"
+	"github.com/papey08/golang-fintech/validation/parse"
+
+	"github.com/pkg/errors"
+)
+
+var ErrInvalidTag = errors.New("invalid validate tag")
+var ErrUnsupportedType = errors.New("type can't be described by JSON Schema")
+
+// Draft is a version of JSON Schema of generated schemas
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// DefsRef is a prefix of references to definitions of named structs in the
+// generated schema. OpenAPI 3.1 documents use "#/components/schemas/"
+const DefsRef = "#/$defs/"
+
+// Schema is a subset of JSON Schema which validate tags can be expressed in
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	ContentEncoding      string             `json:"contentEncoding,omitempty"`
+	Enum                 []any              `json:"enum,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Minimum              json.Number        `json:"minimum,omitempty"`
+	Maximum              json.Number        `json:"maximum,omitempty"`
+	ExclusiveMinimum     json.Number        `json:"exclusiveMinimum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	PropertyNames        *Schema            `json:"propertyNames,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	MinProperties        *int               `json:"minProperties,omitempty"`
+	MaxProperties        *int               `json:"maxProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AllOf                []*Schema          `json:"allOf,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+	Defs                 map[string]*Schema `json:"$defs,omitempty"`
+}
+
+// Options of Generate
+type Options struct {
+	// RefPrefix is a prefix of references to named structs, DefsRef by
+	// default
+	RefPrefix string
+
+	// Groups are active groups of rules, rules of fields with groups tag are
+	// described only if one of their groups is active as in ValidateWith
+	Groups []string
+}
+
+// For returns JSON Schema of type T, see Generate
+func For[T any]() (*Schema, error) {
+	return Generate(reflect.TypeOf((*T)(nil)).Elem(), Options{})
+}
+
+// Generate returns JSON Schema of type t built from validate tags of its
+// fields and fields of nested types. Properties are named after json tags,
+// named structs are put to Defs and referred with opts.RefPrefix. Rules which
+// JSON Schema can't express, e.g. cross-field and registered ones, and rules
+// of groups which are not in opts.Groups are skipped
+func Generate(t reflect.Type, opts Options) (*Schema, error) {
+	if opts.RefPrefix == "" {
+		opts.RefPrefix = DefsRef
+	}
+	g := &generator{
+		opts:      opts,
+		defs:      make(map[string]*Schema),
+		names:     make(map[reflect.Type]string),
+		visiting:  make(map[reflect.Type]bool),
+		recursive: make(map[string]bool),
+		building:  make(map[string]bool),
+	}
+
+	s, err := g.valueSchema(typeutil.Deref(t), nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// root struct is described in place unless it is recursive
+	if name := g.names[typeutil.Deref(t)]; s.Ref != "" && !g.recursive[name] {
+		s = g.defs[name]
+		delete(g.defs, name)
+	}
+	s.Schema = Draft
+	if len(g.defs) != 0 {
+		s.Defs = g.defs
+	}
+	return s, nil
+}
+
+// generator holds definitions of named structs while schema is generated
+type generator struct {
+	opts      Options
+	defs      map[string]*Schema
+	names     map[reflect.Type]string // names of types in defs
+	visiting  map[reflect.Type]bool   // collection types on the current path
+	recursive map[string]bool         // named structs referred while being described
+	building  map[string]bool         // named structs being described
+}
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+// valueSchema returns schema of value of type t checked by rules, path is a
+// path to the value for errors. Values with omitempty may also be empty
+func (g *generator) valueSchema(t reflect.Type, rules []parse.Rule, path string) (*Schema, error) {
+	s, err := g.rulesSchema(t, rules, path)
+	if err != nil {
+		return nil, err
+	}
+	self, _, _, _, _ := parse.SplitDive(rules)
+	if hasRule(self, parse.OmitEmpty) && len(self) > 1 && t.Kind() != reflect.Pointer {
+		if empty := emptySchema(t); empty != nil {
+			return &Schema{AnyOf: []*Schema{empty, s}}, nil
+		}
+	}
+	return s, nil
+}
+
+// rulesSchema returns schema of value of type t restricted by rules
+func (g *generator) rulesSchema(t reflect.Type, rules []parse.Rule, path string) (*Schema, error) {
+	self, keys, elems, dive, invalid := parse.SplitDive(rules)
+	if len(invalid) != 0 {
+		return nil, errors.Wrapf(ErrInvalidTag, "%s: rule %s", path, invalid[0].Name)
+	}
+	for _, rule := range self {
+		if rule.Op == parse.Wrong {
+			return nil, errors.Wrapf(ErrInvalidTag, "%s: rule %s", path, rule.Name)
+		}
+	}
+
+	// pointer to empty value satisfies required
+	if t.Kind() == reflect.Pointer {
+		self = withoutRule(self, parse.Required)
+	}
+	t = typeutil.Deref(t)
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		// elements of recursive collection like type L []L are not described
+		if g.visiting[t] {
+			return &Schema{Type: collectionType(t)}, nil
+		}
+		g.visiting[t] = true
+		defer delete(g.visiting, t)
+	}
+
+	switch {
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}, nil
+
+	case t.Kind() == reflect.Struct:
+		return g.structSchema(t, path)
+
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 && !dive:
+		return &Schema{Type: "string", ContentEncoding: "base64"}, nil
+
+	case t.Kind() == reflect.Slice || t.Kind() == reflect.Array:
+		s := &Schema{Type: "array"}
+		if t.Kind() == reflect.Array {
+			s.MinItems, s.MaxItems = intPtr(t.Len()), intPtr(t.Len())
+		}
+		var err error
+		if !dive {
+			// rules without dive are applied to every element except the
+			// ones which check presence of collection itself
+			var elemRules []parse.Rule
+			for _, rule := range self {
+				if parse.IsPresence(rule.Op) {
+					applyCount([]parse.Rule{rule}, &s.MinItems, &s.MaxItems)
+				} else {
+					elemRules = append(elemRules, rule)
+				}
+			}
+			s.Items, err = g.valueSchema(t.Elem(), elemRules, path+"[]")
+			return s, err
+		}
+		applyCount(self, &s.MinItems, &s.MaxItems)
+		s.Items, err = g.valueSchema(t.Elem(), elems, path+"[]")
+		return s, err
+
+	case t.Kind() == reflect.Map:
+		s := &Schema{Type: "object"}
+		applyCount(self, &s.MinProperties, &s.MaxProperties)
+		var err error
+		if s.AdditionalProperties, err = g.valueSchema(t.Elem(), elems, path+"[]"); err != nil {
+			return nil, err
+		}
+		if len(keys) != 0 {
+			if s.PropertyNames, err = g.valueSchema(t.Key(), keys, path+"[]"); err != nil {
+				return nil, err
+			}
+		}
+		return s, nil
+
+	case dive:
+		return nil, errors.Wrapf(ErrInvalidTag, "%s: rule dive", path)
+	}
+
+	s, err := scalarSchema(t)
+	if err != nil {
+		return nil, errors.Wrap(err, path)
+	}
+	if err = applyRules(s, t, self); err != nil {
+		return nil, errors.Wrap(err, path)
+	}
+	return s, nil
+}
+
+// structSchema returns schema of struct type t. Named structs are described
+// in defs and referred, so recursive types are supported
+func (g *generator) structSchema(t reflect.Type, path string) (*Schema, error) {
+	if t.Name() == "" {
+		s := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+		return s, g.addProperties(s, t, path)
+	}
+
+	if name, ok := g.names[t]; ok {
+		if g.building[name] {
+			g.recursive[name] = true
+		}
+		return &Schema{Ref: g.opts.RefPrefix + name}, nil
+	}
+	name := t.Name()
+	for i := 2; g.defs[name] != nil; i++ { // types with the same name from different packages
+		name = t.Name() + strconv.Itoa(i)
+	}
+	s := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	g.names[t], g.defs[name] = name, s
+	g.building[name] = true
+	defer delete(g.building, name)
+	if err := g.addProperties(s, t, path); err != nil {
+		return nil, err
+	}
+	return &Schema{Ref: g.opts.RefPrefix + name}, nil
+}
+
+// addProperties adds exported fields of struct type t to properties of s the
+// same way encoding/json encodes them, fields of embedded structs without
+// json name are promoted
+func (g *generator) addProperties(s *Schema, t reflect.Type, path string) error {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		if field.Anonymous && name == "" {
+			if ft := typeutil.Deref(field.Type); ft.Kind() == reflect.Struct && ft != timeType {
+				if err := g.addProperties(s, ft, path); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		var rules []parse.Rule
+		tag, ok := field.Tag.Lookup("validate")
+		if groups := parse.Groups(field.Tag.Get("groups")); ok && (len(groups) == 0 || parse.InGroups(groups, g.opts.Groups)) {
+			rules = parse.ValidationRules(tag)
+		}
+		fieldPath := field.Name
+		if path != "" {
+			fieldPath = path + "." + field.Name
+		}
+		fs, err := g.valueSchema(field.Type, rules, fieldPath)
+		if err != nil {
+			return err
+		}
+		if self, _, _, _, _ := parse.SplitDive(rules); hasRule(self, parse.Required) || hasRule(self, parse.NonZero) {
+			s.Required = append(s.Required, name)
+		}
+		if label := field.Tag.Get("label"); label != "" {
+			fs.Title = label
+		}
+		s.Properties[name] = fs
+	}
+	return nil
+}
+
+// scalarSchema returns schema of type of basic kind t without restrictions
+func scalarSchema(t reflect.Type) (*Schema, error) {
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{Type: "string"}, nil
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &Schema{Type: "integer"}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return &Schema{Type: "integer", Minimum: "0"}, nil
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}, nil
+	case reflect.Interface:
+		return &Schema{}, nil // any value
+	default:
+		return nil, ErrUnsupportedType
+	}
+}
+
+func collectionType(t reflect.Type) string {
+	if t.Kind() == reflect.Map {
+		return "object"
+	}
+	return "array"
+}
+
+func intPtr(n int) *int {
+	return &n
+}
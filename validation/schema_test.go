@@ -0,0 +1,191 @@
+package go_course_validation
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/papey08/golang-fintech/validation/schema"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type SchemaBase struct {
+	ID int64 `json:"id" validate:"min:1"`
+}
+
+type SchemaAd struct {
+	SchemaBase
+	Title     string            `json:"title" validate:"lenInterval:1,99" label:"Заголовок"`
+	Text      string            `json:"text,omitempty" validate:"max:500 lenmode:bytes"`
+	Email     string            `json:"email" validate:"email"`
+	Code      string            `json:"code" validate:"regexp:^[A-Z]{3}$;alpha"`
+	Status    string            `json:"status" validate:"in:draft,published"`
+	Priority  int8              `json:"priority" validate:"in:1,2,3"`
+	Count     uint              `json:"count" validate:"min:-1;max:10"`
+	Price     float64           `json:"price" validate:"min:0.01"`
+	Published bool              `json:"published"`
+	Tags      []string          `json:"tags" validate:"max:20"`
+	Codes     []int             `json:"codes" validate:"min:1;dive;min:100"`
+	Stock     map[string]int    `json:"stock" validate:"max:5;dive;keys;len:3;endkeys;min:0"`
+	Items     []*Item           `json:"items" validate:"min:1;dive"`
+	Parent    *Tree             `json:"parent"`
+	CreatedAt time.Time         `json:"created_at" validate:"gtfield:UpdatedAt"`
+	UpdatedAt time.Time         `json:"-"`
+	Photo     []byte            `json:"photo"`
+	Extra     map[string]any    `json:"extra"`
+	Limits    struct{ Max int } `json:"limits"`
+	internal  string
+}
+
+func TestSchema(t *testing.T) {
+	s, err := schema.For[SchemaAd]()
+	assert.NoError(t, err)
+
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"id": {"type": "integer", "minimum": 1},
+			"title": {"type": "string", "title": "Заголовок", "minLength": 1, "maxLength": 99},
+			"text": {"type": "string"},
+			"email": {"type": "string", "format": "email"},
+			"code": {"type": "string", "pattern": "^[A-Z]{3}$", "allOf": [{"pattern": "^\\p{L}+$"}]},
+			"status": {"type": "string", "enum": ["draft", "published"]},
+			"priority": {"type": "integer", "enum": [1, 2, 3]},
+			"count": {"type": "integer", "minimum": 0, "maximum": 10},
+			"price": {"type": "number", "minimum": 0.01},
+			"published": {"type": "boolean"},
+			"tags": {"type": "array", "items": {"type": "string", "maxLength": 20}},
+			"codes": {"type": "array", "minItems": 1, "items": {"type": "integer", "minimum": 100}},
+			"stock": {
+				"type": "object",
+				"maxProperties": 5,
+				"propertyNames": {"type": "string", "minLength": 3, "maxLength": 3},
+				"additionalProperties": {"type": "integer", "minimum": 0}
+			},
+			"items": {"type": "array", "minItems": 1, "items": {"$ref": "#/$defs/Item"}},
+			"parent": {"$ref": "#/$defs/Tree"},
+			"created_at": {"type": "string", "format": "date-time"},
+			"photo": {"type": "string", "contentEncoding": "base64"},
+			"extra": {"type": "object", "additionalProperties": {}},
+			"limits": {"type": "object", "properties": {"Max": {"type": "integer"}}}
+		},
+		"$defs": {
+			"Item": {
+				"type": "object",
+				"properties": {
+					"Name": {"type": "string", "minLength": 1},
+					"Price": {"type": "integer", "minimum": 0}
+				}
+			},
+			"Tree": {
+				"type": "object",
+				"properties": {
+					"Value": {"type": "integer", "minimum": 0},
+					"Children": {"type": "array", "items": {"$ref": "#/$defs/Tree"}}
+				}
+			}
+		}
+	}`, string(data))
+}
+
+func TestSchemaOptions(t *testing.T) {
+	s, err := schema.Generate(reflect.TypeOf(&Tree{}), schema.Options{RefPrefix: "#/components/schemas/"})
+	assert.NoError(t, err)
+	assert.Equal(t, "#/components/schemas/Tree", s.Ref)
+	assert.Equal(t, "#/components/schemas/Tree", s.Defs["Tree"].Properties["Children"].Items.Ref)
+
+	_, err = schema.For[struct {
+		A string `validate:"min:abc"`
+	}]()
+	assert.ErrorIs(t, err, schema.ErrInvalidTag)
+
+	_, err = schema.For[struct {
+		A int `validate:"dive"`
+	}]()
+	assert.ErrorIs(t, err, schema.ErrInvalidTag)
+
+	_, err = schema.For[struct {
+		A chan int
+	}]()
+	assert.ErrorIs(t, err, schema.ErrUnsupportedType)
+
+	s, err = schema.For[List]()
+	assert.NoError(t, err)
+	assert.Equal(t, "array", s.Items.Type)
+}
+
+func TestSchemaTimeRules(t *testing.T) {
+	s, err := schema.For[Profile]()
+	assert.NoError(t, err)
+	assert.Equal(t, &schema.Schema{Type: "string", Format: "date-time"}, s.Properties["Birthday"])
+	assert.Equal(t, json.Number("1000000000"), s.Properties["Timeout"].Minimum)
+	assert.Equal(t, json.Number("90000000000"), s.Properties["Timeout"].Maximum)
+	assert.Equal(t, []any{int64(1000000000), int64(2000000000), int64(5000000000)}, s.Properties["Retry"].Enum)
+}
+
+func TestSchemaMoneyRules(t *testing.T) {
+	s, err := schema.For[struct {
+		Amount   string   `validate:"positive;scale:2"`
+		Fee      int64    `validate:"positive;decmin:-1.5;decmax:+10000"`
+		Ratio    float64  `validate:"positive"`
+		Currency string   `validate:"currency"`
+		Accepted []string `validate:"currency:RUB,USD"`
+	}]()
+	assert.NoError(t, err)
+
+	amount := s.Properties["Amount"]
+	assert.Equal(t, `^[+-]?[0-9]+(\.[0-9]+)?$`, amount.Pattern)
+	assert.Equal(t, `^[+-]?[0-9]+(\.[0-9]{1,2}0*)?$`, amount.AllOf[0].Pattern)
+	pattern := regexp.MustCompile(amount.AllOf[0].Pattern)
+	assert.True(t, pattern.MatchString("10.500"))
+	assert.False(t, pattern.MatchString("10.501"))
+	assert.False(t, pattern.MatchString("10."))
+
+	assert.Equal(t, json.Number("1"), s.Properties["Fee"].Minimum)
+	assert.Equal(t, json.Number("10000"), s.Properties["Fee"].Maximum)
+	assert.Equal(t, json.Number("0"), s.Properties["Ratio"].ExclusiveMinimum)
+	assert.Contains(t, s.Properties["Currency"].Enum, "RUB")
+	assert.NotContains(t, s.Properties["Currency"].Enum, "XXX")
+	assert.Equal(t, []any{"RUB", "USD"}, s.Properties["Accepted"].Items.Enum)
+}
+
+func TestSchemaGroups(t *testing.T) {
+	s, err := schema.For[AdInput]()
+	assert.NoError(t, err)
+	assert.Empty(t, s.Properties["Title"].MinLength)
+	assert.Equal(t, 5, *s.Properties["Text"].MaxLength)
+
+	s, err = schema.Generate(reflect.TypeOf(AdInput{}), schema.Options{Groups: []string{"create"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *s.Properties["Title"].MinLength)
+	assert.Equal(t, 1, *s.Properties["Tags"].Items.MinLength)
+	assert.Empty(t, s.Properties["ID"].Minimum)
+}
+
+func TestSchemaPresenceRules(t *testing.T) {
+	s, err := schema.For[Signup]()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Name", "Age", "Tags", "Meta", "Born", "Agreed", "Score"}, s.Required)
+	assert.Equal(t, 1, *s.Properties["Name"].MinLength)
+	assert.Equal(t, json.Number("18"), s.Properties["Age"].Minimum)
+	assert.Equal(t, 1, *s.Properties["Tags"].MinItems)
+	assert.Equal(t, 1, *s.Properties["Meta"].MinProperties)
+
+	// omitempty allows empty value besides the one described by other rules
+	tags := s.Properties["Tags"].Items
+	assert.Len(t, tags.AnyOf, 2)
+	assert.Equal(t, 0, *tags.AnyOf[0].MaxLength)
+	assert.Equal(t, 2, *tags.AnyOf[1].MinLength)
+
+	ref := s.Defs["Reference"]
+	assert.Equal(t, []string{"Phone", "Note"}, ref.Required)
+	assert.Empty(t, ref.Properties["Phone"].MinLength) // pointer to "" is present
+	assert.Equal(t, 1, *ref.Properties["Note"].MinLength)
+	assert.Equal(t, "email", ref.Properties["Email"].AnyOf[1].Format)
+}
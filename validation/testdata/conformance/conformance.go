@@ -0,0 +1,1032 @@
+// Package conformance contains fixtures of the conformance test: named
+// structs with generated Validate methods and cases with expected errors.
+// validation.Validate is tested on Cases, and generated code is checked to
+// report the same errors on them. The package is in testdata, so only tests
+// of validation build it
+package conformance
+
+//go:generate go run github.com/papey08/golang-fintech/validation/cmd/validategen
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+
+	validation "github.com/papey08/golang-fintech/validation"
+	"github.com/papey08/golang-fintech/validation/check"
+)
+
+// Case is a value checked by validation.Validate and, if the value has
+// generated Validate method, by generated code
+type Case struct {
+	Name    string
+	Value   any
+	WantErr bool
+	Check   func(t T, err error) // optional checks of the error
+}
+
+type Title string
+
+type Code uint8
+
+type Empty struct{}
+
+type Untagged struct {
+	f1 string
+	f2 string
+}
+
+type Unexported struct {
+	foo string `validate:"len:10"`
+}
+
+type InvalidSyntax struct {
+	Foo string `validate:"len:abcdef"`
+}
+
+type Tagged struct {
+	Len       string `validate:"len:20"`
+	LenZ      string `validate:"len:0"`
+	InInt     int    `validate:"in:20,25,30"`
+	InNeg     int    `validate:"in:-20,-25,-30"`
+	InStr     string `validate:"in:foo,bar"`
+	MinInt    int    `validate:"min:10"`
+	MinIntNeg int    `validate:"min:-10"`
+	MinStr    string `validate:"min:10"`
+	MinStrNeg string `validate:"min:-1"`
+	MaxInt    int    `validate:"max:20"`
+	MaxIntNeg int    `validate:"max:-2"`
+	MaxStr    string `validate:"max:20"`
+}
+
+type WrongLength struct {
+	Lower    string `validate:"len:24"`
+	Higher   string `validate:"len:5"`
+	Zero     string `validate:"len:3"`
+	BadSpec  string `validate:"len:%12"`
+	Negative string `validate:"len:-6"`
+}
+
+type WrongIn struct {
+	InA     string `validate:"in:ab,cd"`
+	InB     string `validate:"in:aa,bb,cd,ee"`
+	InC     int    `validate:"in:-1,-3,5,7"`
+	InD     int    `validate:"in:5-"`
+	InEmpty string `validate:"in:"`
+}
+
+type WrongMin struct {
+	MinA string `validate:"min:12"`
+	MinB int    `validate:"min:-12"`
+	MinC int    `validate:"min:5-"`
+	MinD int    `validate:"min:"`
+	MinE string `validate:"min:"`
+}
+
+type WrongMax struct {
+	MaxA string `validate:"max:2"`
+	MaxB string `validate:"max:-7"`
+	MaxC int    `validate:"max:-12"`
+	MaxD int    `validate:"max:5-"`
+	MaxE int    `validate:"max:"`
+	MaxF string `validate:"max:"`
+}
+
+type IntSlices struct {
+	MinNums []int `validate:"min:0"`
+	MaxNums []int `validate:"max:10"`
+}
+
+type StringSlices struct {
+	ShortStrings []string `validate:"len:10"`
+	LongStrings  []string `validate:"len:10"`
+}
+
+type Slices struct {
+	ShortStrings []string `validate:"min:5"`
+	LongStrings  []string `validate:"min:5"`
+	SmallNums    []int    `validate:"max:10"`
+	BigNums      []int    `validate:"max:10"`
+	PrimeNums    []int    `validate:"in:2,3,5,7,11"`
+	PiDigits     []int    `validate:"in:2,3,5,7,11"`
+}
+
+type ValidSlices struct {
+	ShortStrings []string `validate:"max:5"`
+	LongStrings  []string `validate:"min:5"`
+	SmallNums    []int    `validate:"max:10"`
+	BigNums      []int    `validate:"min:10"`
+	PrimeNums    []int    `validate:"in:2,3,5,7,11"`
+	PiDigits     []int    `validate:"in:0,1,2,3,4,5,6,7,8,9"`
+}
+
+type NestedStruct struct {
+	N int    `validate:"max:5"`
+	S string `validate:"len:3"`
+}
+
+type StructWithNestedStructs struct {
+	NestedStruct1 NestedStruct
+	NestedStruct2 NestedStruct
+}
+
+type Ad struct {
+	ID        int64
+	Title     string `validate:"lenInterval:1,99"`
+	Text      string `validate:"lenInterval:1,499"`
+	AuthorID  int64
+	Published bool
+}
+
+type SeveralRules struct {
+	Title  string `validate:"min:1;max:99"`
+	Text   string `validate:"min:1 max:499"`
+	Status string `validate:"len:5;in:draft,ready"`
+	Sep    string `validate:"in:'a,b','c:d';len:3"`
+	Quote  string `validate:"in:'it\\'s',other"`
+	Score  int    `validate:" min:0 ;; max:100 "`
+}
+
+type EveryRule struct {
+	Title string `validate:"min:10;max:2"`
+	Score int    `validate:"min:0 in:1,2 max:-1"`
+	Both  string `validate:"len:abc;in:x"`
+}
+
+type UnterminatedQuote struct {
+	Foo string `validate:"in:'a,b;len:3"`
+}
+
+type Kinds struct {
+	ID       int64   `validate:"min:1"`
+	Small    int8    `validate:"in:-1,0,1"`
+	Count    uint32  `validate:"min:-5;max:10"`
+	Big      uint64  `validate:"min:18446744073709551615"`
+	Price    float64 `validate:"min:0.01;max:99.99"`
+	Rate     float32 `validate:"in:0.1,0.2"`
+	Title    Title   `validate:"min:1;max:99"`
+	Code     Code    `validate:"in:1,2,3"`
+	Optional *string `validate:"len:3"`
+	Present  *int64  `validate:"max:10"`
+	IDs      []int64 `validate:"min:1"`
+}
+
+// Interface is checked by validation.Validate in generated code
+type Interface struct {
+	Any any `validate:"max:3"`
+}
+
+type WrongKinds struct {
+	ID      int64     `validate:"min:1"`
+	Count   uint32    `validate:"max:-1"`
+	Price   float64   `validate:"min:0.01"`
+	Title   Title     `validate:"max:3"`
+	Code    Code      `validate:"in:1,2,3"`
+	Present *string   `validate:"len:3"`
+	Small   int8      `validate:"in:1000"`
+	Len     int       `validate:"len:3"`
+	Flag    bool      `validate:"in:true"`
+	Date    time.Time `validate:"min:1"`
+}
+
+type Formats struct {
+	Login   string  `validate:"regexp:^[a-z][a-z0-9_]{2,15}$"`
+	Quoted  string  `validate:"regexp:'^[a-z]+ [a-z]+$'"`
+	Email   string  `validate:"email"`
+	Site    string  `validate:"url"`
+	ID      string  `validate:"uuid"`
+	Phone   string  `validate:"phone"`
+	Name    string  `validate:"alpha"`
+	Account string  `validate:"alnum;len:8"`
+	Comment string  `validate:"ascii"`
+	INN     string  `validate:"numeric;len:10"`
+	Hash    string  `validate:"hex"`
+	Tags    []Title `validate:"alpha"`
+}
+
+type WrongFormats struct {
+	Login   string `validate:"regexp:^[a-z]{2,4}$"`
+	Email   string `validate:"email"`
+	Named   string `validate:"email"`
+	Site    string `validate:"url"`
+	ID      string `validate:"uuid"`
+	Phone   string `validate:"phone"`
+	Name    string `validate:"alpha"`
+	Account string `validate:"alnum"`
+	Comment string `validate:"ascii"`
+	INN     string `validate:"numeric"`
+	Hash    string `validate:"hex"`
+	BadRe   string `validate:"regexp:a(b"`
+	BadArgs string `validate:"email:strict"`
+	Number  int    `validate:"numeric"`
+}
+
+type LengthModes struct {
+	Title    string `validate:"lenInterval:1,99"`
+	Code     string `validate:"len:3"`
+	Bytes    string `validate:"max:6;lenmode:bytes"`
+	Emoji    string `validate:"lenmode:graphemes;len:2"`
+	EmojiRun string `validate:"len:7"`
+}
+
+type WrongLengthModes struct {
+	Title  string `validate:"lenmode:bytes;lenInterval:1,99"`
+	Emoji  string `validate:"lenmode:graphemes;max:1"`
+	Mode   string `validate:"lenmode:chars"`
+	Twice  string `validate:"lenmode:bytes;lenmode:runes"`
+	Number int    `validate:"lenmode:bytes;max:5"`
+}
+
+type Schedule struct {
+	Start   time.Time       `validate:"after:2020-01-01;before:2030-01-01T00:00:00+03:00"`
+	Days    []time.Time     `validate:"weekday:sat,sun;tz:Europe/Moscow"`
+	End     *time.Time      `validate:"before:now"`
+	Timeout time.Duration   `validate:"min:1s;max:1m"`
+	Retries []time.Duration `validate:"in:1s,2s"`
+}
+
+// Cents is a decimal implementing check.Decimal with value receivers
+type Cents struct {
+	Units int64
+	Exp   int32
+}
+
+func (c Cents) Coefficient() *big.Int { return big.NewInt(c.Units) }
+func (c Cents) Exponent() int32       { return c.Exp }
+
+// Rate implements check.Decimal with pointer receivers
+type Rate struct {
+	Coef *big.Int
+	Exp  int32
+}
+
+func (r *Rate) Coefficient() *big.Int { return r.Coef }
+func (r *Rate) Exponent() int32       { return r.Exp }
+
+type Transfer struct {
+	Amount   string   `validate:"positive;scale:2;precision:18"`
+	Fee      int64    `validate:"decmin:0;decmax:10000"`
+	Total    Cents    `validate:"positive;scale:2;decmax:1000000.00"`
+	Limit    *Cents   `validate:"min:0.01;max:99.99"`
+	Currency string   `validate:"currency:ISO4217"`
+	Accepted []string `validate:"currency:RUB,USD"`
+	Shares   []Rate   `validate:"decmin:0.1;decmax:0.3"`
+	Ratio    float64  `validate:"positive;scale:2"`
+}
+
+// AdInput has rules of groups, which generated Validate skips as Validate
+// does
+type AdInput struct {
+	ID    int64    `validate:"min:1" groups:"update"`
+	Title string   `validate:"min:1;max:99" groups:"create"`
+	Text  string   `validate:"max:5"`
+	Tags  []string `validate:"dive;min:1" groups:"create,update"`
+	Items []Item   `validate:"min:1" groups:"create"`
+}
+
+// Signup has presence rules, which generated code checks without reflection
+type Signup struct {
+	Name   string            `validate:"required;max:16"`
+	Age    *int              `validate:"required;min:18"`
+	Code   *Title            `validate:"nonzero"`
+	Count  **int             `validate:"nonzero"`
+	Meta   map[string]string `validate:"required"`
+	Extra  any               `validate:"required"`
+	Born   time.Time         `validate:"nonzero"`
+	Email  string            `validate:"omitempty;email"`
+	Score  float64           `validate:"nonzero"`
+	Agreed bool              `validate:"nonzero"`
+	Items  []Item            `validate:"omitempty"`
+	Sizes  []uint8           `validate:"omitempty;max:40"`
+	Bad    string            `validate:"omitempty;required"`
+}
+
+type Limits struct {
+	Max int
+}
+
+// CrossFields is checked by validation.Validate in generated code
+type CrossFields struct {
+	DateFrom        time.Time
+	DateTo          time.Time `validate:"gtfield:DateFrom"`
+	Password        string
+	PasswordConfirm string `validate:"eqfield:Password"`
+	OldPassword     string `validate:"nefield:Password"`
+	Published       bool
+	Reason          string `validate:"required_if:Published,false"`
+	MinPrice        int
+	MaxPrice        *float64 `validate:"gtefield:MinPrice"`
+	Limits          *Limits
+	Amount          any `validate:"ltefield:Limits.Max"`
+}
+
+// UnknownFields refers to fields which can't be compared
+type UnknownFields struct {
+	A int    `validate:"eqfield:B"`
+	B string `validate:"ltfield:a"`
+	C int    `validate:"gtfield:A.B"`
+	D string `validate:"required_if:A"`
+	E string `validate:"eqfield:A"`
+	a int
+}
+
+type Item struct {
+	Name  string `validate:"min:1" label:"Item name"`
+	Price int    `validate:"min:0"`
+}
+
+// Collections contains elements checked without dive and structs inside
+// pointers and collections
+type Collections struct {
+	Matrix  [][]int     `validate:"max:9"`
+	Fixed   [2]*string  `validate:"len:2"`
+	PtrTags *[]Title    `validate:"min:2"`
+	Items   []Item      `validate:"lenmode:bytes"`
+	ByPtr   []*Item     `label:"items"`
+	Grid    [2][]Item   `validate:"min:1"`
+	Main    *Item       `validate:"max:1"`
+	Labels  map[int]int `label:"labels"`
+}
+
+// Dive is checked by validation.Validate in generated code
+type Dive struct {
+	Tags  []string       `validate:"max:2;dive;len:3"`
+	Stock map[string]int `validate:"dive;min:0"`
+}
+
+// Checked has hand-written Validate method
+type Checked struct {
+	Value int
+}
+
+func (c Checked) Validate() error {
+	if c.Value < 0 {
+		return errors.New("value is negative")
+	}
+	return nil
+}
+
+// Account checks its own invariants with context
+type Account struct {
+	Login   string `validate:"min:3"`
+	Balance int
+	Checked Checked
+	History []*Checked
+}
+
+func (a *Account) ValidateContext(ctx context.Context) error {
+	if a.Balance < 0 && !strings.HasPrefix(a.Login, "bank") {
+		return errors.New("balance is negative")
+	}
+	return nil
+}
+
+// Node is recursive, so it is checked by validation.Validate in generated code
+type Node struct {
+	Value int `validate:"min:0"`
+	Next  *Node
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// T is implemented by *testing.T. Checks don't use testing packages, since
+// validategen type-checks imports of the package from source
+type T interface {
+	Helper()
+	Errorf(format string, args ...any)
+}
+
+func expectIs(t T, err, target error) {
+	t.Helper()
+	if !errors.Is(err, target) {
+		t.Errorf("expected error %q to be %q", err, target)
+	}
+}
+
+func expectNotIs(t T, err, target error) {
+	t.Helper()
+	if errors.Is(err, target) {
+		t.Errorf("expected error %q not to be %q", err, target)
+	}
+}
+
+func expectEqual(t T, want, got any) {
+	t.Helper()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("expected %#v, got %#v", want, got)
+	}
+}
+
+// expectLen reports whether ve has n errors
+func expectLen(t T, ve validation.ValidationErrors, n int) bool {
+	t.Helper()
+	if len(ve) != n {
+		t.Errorf("expected %d errors, got %d: %v", n, len(ve), ve)
+		return false
+	}
+	return true
+}
+
+// errorsOf returns ValidationErrors of err or fails t
+func errorsOf(t T, err error) validation.ValidationErrors {
+	t.Helper()
+	var ve validation.ValidationErrors
+	if !errors.As(err, &ve) {
+		t.Errorf("expected ValidationErrors, got %v", err)
+	}
+	return ve
+}
+
+func fieldsOf(ve validation.ValidationErrors) []string {
+	res := make([]string, len(ve))
+	for i := range ve {
+		res[i] = ve[i].Field
+	}
+	return res
+}
+
+// hasErrors returns Check which expects n ValidationErrors
+func hasErrors(n int) func(t T, err error) {
+	return func(t T, err error) {
+		t.Helper()
+		expectLen(t, errorsOf(t, err), n)
+	}
+}
+
+// hasError returns Check which expects the only ValidationError wrapping
+// target
+func hasError(target error) func(t T, err error) {
+	return func(t T, err error) {
+		t.Helper()
+		expectLen(t, errorsOf(t, err), 1)
+		expectIs(t, err, target)
+	}
+}
+
+// Cases are fixtures of validation.Validate tests. Values with generated
+// Validate method are also used to check generated code
+var Cases = []Case{
+	{
+		Name:    "invalid struct: interface",
+		Value:   new(any),
+		WantErr: true,
+		Check: func(t T, err error) {
+			expectIs(t, err, validation.ErrNotStruct)
+		},
+	},
+	{
+		Name:    "invalid struct: map",
+		Value:   map[string]string{},
+		WantErr: true,
+		Check: func(t T, err error) {
+			expectIs(t, err, validation.ErrNotStruct)
+		},
+	},
+	{
+		Name:    "invalid struct: string",
+		Value:   "some string",
+		WantErr: true,
+		Check: func(t T, err error) {
+			expectIs(t, err, validation.ErrNotStruct)
+		},
+	},
+	{
+		Name:    "invalid struct: nil",
+		Value:   nil,
+		WantErr: true,
+		Check: func(t T, err error) {
+			expectIs(t, err, validation.ErrNilValue)
+			expectIs(t, err, validation.ErrNotStruct)
+		},
+	},
+	{
+		Name:    "invalid struct: nil pointer",
+		Value:   (*NestedStruct)(nil),
+		WantErr: true,
+		Check: func(t T, err error) {
+			expectIs(t, err, validation.ErrNilValue)
+		},
+	},
+	{
+		Name:    "invalid struct: pointer to string",
+		Value:   new(string),
+		WantErr: true,
+		Check: func(t T, err error) {
+			expectIs(t, err, validation.ErrNotStruct)
+			expectNotIs(t, err, validation.ErrNilValue)
+		},
+	},
+	{Name: "valid pointer to struct", Value: &NestedStruct{N: 5, S: "abc"}},
+	{
+		Name:    "wrong pointer to pointer to struct",
+		Value:   ptr(&NestedStruct{N: 6, S: "abc"}),
+		WantErr: true,
+		Check: func(t T, err error) {
+			ve := errorsOf(t, err)
+			if expectLen(t, ve, 1) {
+				expectEqual(t, "N", ve[0].Field)
+			}
+		},
+	},
+	{Name: "valid struct with no fields", Value: &Empty{}},
+	{Name: "valid struct with untagged fields", Value: &Untagged{}},
+	{
+		Name:    "valid struct with unexported fields",
+		Value:   &Unexported{},
+		WantErr: true,
+		Check:   hasError(validation.ErrValidateForUnexportedFields),
+	},
+	{
+		Name:    "invalid validator syntax",
+		Value:   &InvalidSyntax{},
+		WantErr: true,
+		Check:   hasError(validation.ErrInvalidValidatorSyntax),
+	},
+	{
+		Name: "valid struct with tagged fields",
+		Value: &Tagged{
+			Len:       "abcdefghjklmopqrstvu",
+			LenZ:      "",
+			InInt:     25,
+			InNeg:     -25,
+			InStr:     "bar",
+			MinInt:    15,
+			MinIntNeg: -9,
+			MinStr:    "abcdefghjkl",
+			MinStrNeg: "abc",
+			MaxInt:    16,
+			MaxIntNeg: -3,
+			MaxStr:    "abcdefghjklmopqrst",
+		},
+	},
+	{
+		Name:    "wrong tagged fields",
+		Value:   &Tagged{InStr: "baz", MinStrNeg: "", MaxIntNeg: 0},
+		WantErr: true,
+	},
+	{
+		Name: "wrong length",
+		Value: &WrongLength{
+			Lower:    "abcdef",
+			Higher:   "abcdef",
+			Zero:     "",
+			BadSpec:  "abc",
+			Negative: "abcd",
+		},
+		WantErr: true,
+		Check:   hasErrors(5),
+	},
+	{
+		Name: "wrong in",
+		Value: &WrongIn{
+			InA:     "ef",
+			InB:     "ab",
+			InC:     2,
+			InD:     12,
+			InEmpty: "",
+		},
+		WantErr: true,
+		Check:   hasErrors(5),
+	},
+	{
+		Name: "wrong min",
+		Value: &WrongMin{
+			MinA: "ef",
+			MinB: -22,
+			MinC: 12,
+			MinD: 11,
+			MinE: "abc",
+		},
+		WantErr: true,
+		Check:   hasErrors(5),
+	},
+	{
+		Name: "wrong max",
+		Value: &WrongMax{
+			MaxA: "efgh",
+			MaxB: "ab",
+			MaxC: 22,
+			MaxD: 12,
+			MaxE: 11,
+			MaxF: "abc",
+		},
+		WantErr: true,
+		Check:   hasErrors(6),
+	},
+	{
+		Name: "wrong field of type []int",
+		Value: &IntSlices{
+			MinNums: []int{9, 10, 11},
+			MaxNums: []int{9, 10, 11},
+		},
+		WantErr: true,
+		Check:   hasErrors(1),
+	},
+	{
+		Name: "wrong field of type []string",
+		Value: &StringSlices{
+			ShortStrings: []string{"abc", "def", "ghi"},
+			LongStrings:  []string{"abcdefghij", "klmnopqrst"},
+		},
+		WantErr: true,
+		Check:   hasErrors(1),
+	},
+	{
+		Name: "wrong fields of type []int and []string",
+		Value: &Slices{
+			ShortStrings: []string{"abc", "def", "ghijk"},
+			LongStrings:  []string{"AntonOcean", "kuai6", "mikhail-chebakov", "TimRazumov"},
+			SmallNums:    []int{5, 4, 3, 2, 1},
+			BigNums:      []int{2904, 46447, 1210},
+			PrimeNums:    []int{5, 5, 7, 2, 3, 2},
+			PiDigits:     []int{3, 1, 4, 1, 5, 9, 2, 6, 5, 3, 5},
+		},
+		WantErr: true,
+		Check:   hasErrors(3),
+	},
+	{
+		Name: "all valid fields",
+		Value: &ValidSlices{
+			ShortStrings: []string{"abc", "def", "ghijk"},
+			LongStrings:  []string{"AntonOcean", "kuai6", "mikhail-chebakov", "TimRazumov"},
+			SmallNums:    []int{5, 4, 3, 2, 1},
+			BigNums:      []int{2904, 46447, 1210},
+			PrimeNums:    []int{5, 5, 7, 2, 3, 2},
+			PiDigits:     []int{3, 1, 4, 1, 5, 9, 2, 6, 5, 3, 5},
+		},
+	},
+	{
+		Name: "correct struct with nested structs",
+		Value: &StructWithNestedStructs{
+			NestedStruct1: NestedStruct{N: 1, S: "abc"},
+			NestedStruct2: NestedStruct{N: 2, S: "def"},
+		},
+	},
+	{
+		Name: "struct with 1 invalid nested struct",
+		Value: &StructWithNestedStructs{
+			NestedStruct1: NestedStruct{N: 5, S: "ghi"},
+			NestedStruct2: NestedStruct{N: 6, S: "jklmno"},
+		},
+		WantErr: true,
+		Check: func(t T, err error) {
+			ve := errorsOf(t, err)
+			if expectLen(t, ve, 2) {
+				expectEqual(t, "NestedStruct2.N", ve[0].Field)
+				expectEqual(t, "NestedStruct2.S", ve[1].Field)
+			}
+		},
+	},
+	{
+		Name: "valid Ad struct",
+		Value: &Ad{
+			ID:        10,
+			Title:     "Ad with valid title and text",
+			Text:      "Text of the valid ad",
+			AuthorID:  2,
+			Published: false,
+		},
+	},
+	{
+		Name: "invalid Ad struct",
+		Value: &Ad{
+			ID:        10,
+			Title:     "Ad with empty text",
+			Text:      "",
+			AuthorID:  2,
+			Published: false,
+		},
+		WantErr: true,
+		Check:   hasError(check.ErrInvalidFieldValue),
+	},
+	{
+		Name: "valid struct with several rules per tag",
+		Value: &SeveralRules{
+			Title:  "Ad title",
+			Text:   "Ad text",
+			Status: "ready",
+			Sep:    "c:d",
+			Quote:  "it's",
+			Score:  50,
+		},
+	},
+	{
+		Name:    "every failed rule of tag is reported",
+		Value:   &EveryRule{Title: "abcde", Score: 5, Both: "y"},
+		WantErr: true,
+		Check:   hasErrors(6),
+	},
+	{
+		Name:    "unterminated quote in tag",
+		Value:   &UnterminatedQuote{},
+		WantErr: true,
+		Check:   hasError(validation.ErrInvalidValidatorSyntax),
+	},
+	{
+		Name: "valid struct with numeric kinds, pointers and named types",
+		Value: &Kinds{
+			ID:       100,
+			Small:    -1,
+			Count:    10,
+			Big:      18446744073709551615,
+			Price:    99.99,
+			Rate:     0.2,
+			Title:    "Заголовок",
+			Code:     2,
+			Optional: nil,
+			Present:  new(int64),
+			IDs:      []int64{1, 2, 3},
+		},
+	},
+	{Name: "valid interface field", Value: &Interface{Any: "abc"}},
+	{
+		Name: "wrong numeric kinds, pointers and named types",
+		Value: &WrongKinds{
+			ID:      0,
+			Count:   0,
+			Price:   0.001,
+			Title:   "long title",
+			Code:    4,
+			Present: new(string),
+			Small:   1,
+			Len:     3,
+			Flag:    true,
+		},
+		WantErr: true,
+		Check: func(t T, err error) {
+			ve := errorsOf(t, err)
+			if !expectLen(t, ve, 10) {
+				return
+			}
+			for _, e := range ve[:6] {
+				expectIs(t, e, check.ErrInvalidFieldValue)
+			}
+			for _, e := range ve[6:] {
+				expectIs(t, e, check.ErrInvalidFieldType)
+			}
+		},
+	},
+	{
+		Name: "valid struct with string formats",
+		Value: &Formats{
+			Login:   "papey_08",
+			Quoted:  "hello world",
+			Email:   "user@example.com",
+			Site:    "https://example.com/ads?id=1",
+			ID:      "123e4567-e89b-12d3-a456-426614174000",
+			Phone:   "+79991234567",
+			Name:    "Иван",
+			Account: "ab12CD34",
+			Comment: "plain text!",
+			INN:     "7707083893",
+			Hash:    "deadBEEF",
+			Tags:    []Title{"sale", "новинка"},
+		},
+	},
+	{
+		Name: "wrong string formats",
+		Value: &WrongFormats{
+			Login:   "abcdef",
+			Email:   "user@",
+			Named:   "User <user@example.com>",
+			Site:    "example.com",
+			ID:      "123e4567e89b12d3a456426614174000",
+			Phone:   "89991234567",
+			Name:    "Ivan2",
+			Account: "ab-12",
+			Comment: "текст",
+			INN:     "",
+			Hash:    "0xff",
+			BadRe:   "ab",
+			BadArgs: "user@example.com",
+			Number:  12,
+		},
+		WantErr: true,
+		Check: func(t T, err error) {
+			ve := errorsOf(t, err)
+			if !expectLen(t, ve, 14) {
+				return
+			}
+			for _, e := range ve[:11] {
+				expectIs(t, e, check.ErrInvalidFieldValue)
+			}
+			expectIs(t, ve[11], validation.ErrInvalidValidatorSyntax)
+			expectIs(t, ve[12], validation.ErrInvalidValidatorSyntax)
+			expectIs(t, ve[13], check.ErrInvalidFieldType)
+		},
+	},
+	{
+		Name: "length of string is measured in runes by default",
+		Value: &LengthModes{
+			Title:    strings.Repeat("Объявление", 6),
+			Code:     "руб",
+			Bytes:    "руб",
+			Emoji:    "👨‍👩‍👧👍🏽",
+			EmojiRun: "👨‍👩‍👧👍🏽",
+		},
+	},
+	{
+		Name: "wrong length in bytes and graphemes",
+		Value: &WrongLengthModes{
+			Title:  strings.Repeat("Объявление", 6),
+			Emoji:  "👨‍👩‍👧👍🏽",
+			Mode:   "abc",
+			Twice:  "abc",
+			Number: 1,
+		},
+		WantErr: true,
+		Check: func(t T, err error) {
+			ve := errorsOf(t, err)
+			if !expectLen(t, ve, 4) {
+				return
+			}
+			expectIs(t, ve[0], check.ErrInvalidFieldValue)
+			expectIs(t, ve[1], check.ErrInvalidFieldValue)
+			expectIs(t, ve[2], validation.ErrInvalidValidatorSyntax)
+			expectIs(t, ve[3], validation.ErrInvalidValidatorSyntax)
+		},
+	},
+	{
+		Name: "valid cross-field rules",
+		Value: &CrossFields{
+			DateFrom:        time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			DateTo:          time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+			Password:        "secret",
+			PasswordConfirm: "secret",
+			OldPassword:     "qwerty",
+			Published:       false,
+			Reason:          "draft",
+			MinPrice:        10,
+			MaxPrice:        ptr(20.5),
+			Limits:          &Limits{Max: 100},
+			Amount:          uint8(100),
+		},
+	},
+	{
+		Name: "wrong cross-field rules",
+		Value: &CrossFields{
+			DateFrom:        time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+			DateTo:          time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			Password:        "secret",
+			PasswordConfirm: "Secret",
+			OldPassword:     "secret",
+			Published:       false,
+			MinPrice:        10,
+			Limits:          &Limits{Max: 99},
+			Amount:          uint8(100),
+		},
+		WantErr: true,
+		Check: func(t T, err error) {
+			ve := errorsOf(t, err)
+			if !expectLen(t, ve, 5) {
+				return
+			}
+			expectEqual(t, "DateTo: rule gtfield:DateFrom failed for value 2023-01-01 00:00:00 +0000 UTC: value of field is not validate", ve[0].Error())
+			expectEqual(t, validation.ValidationError{
+				Field: "PasswordConfirm",
+				Rule:  "eqfield",
+				Args:  []string{"Password"},
+				Value: "Secret",
+				Err:   check.ErrInvalidFieldValue,
+			}, ve[1])
+			expectEqual(t, "OldPassword", ve[2].Field)
+			expectEqual(t, "Reason", ve[3].Field)
+			expectEqual(t, "Amount", ve[4].Field)
+		},
+	},
+	{
+		Name:    "cross-field rules with unknown fields",
+		Value:   &UnknownFields{},
+		WantErr: true,
+		Check: func(t T, err error) {
+			ve := errorsOf(t, err)
+			if !expectLen(t, ve, 5) {
+				return
+			}
+			expectIs(t, ve[0], check.ErrInvalidFieldType)
+			for _, e := range ve[1:4] {
+				expectIs(t, e, validation.ErrInvalidValidatorSyntax)
+			}
+			expectIs(t, ve[4], check.ErrInvalidFieldType)
+		},
+	},
+	{Name: "empty collections", Value: &Collections{}},
+	{
+		Name: "wrong elements of collections",
+		Value: &Collections{
+			Matrix:  [][]int{{1, 2}, {3, 10, 11}},
+			Fixed:   [2]*string{nil, ptr("abc")},
+			PtrTags: &[]Title{"ab", "c"},
+			Items:   []Item{{Name: "a"}, {Price: -1}},
+			ByPtr:   []*Item{nil, {Name: "b", Price: -2}},
+			Grid:    [2][]Item{nil, {{}}},
+			Main:    &Item{Price: -3},
+		},
+		WantErr: true,
+	},
+	{
+		Name: "wrong dive",
+		Value: &Dive{
+			Tags:  []string{"abc", "de", "fgh"},
+			Stock: map[string]int{"b": -1, "a": -2},
+		},
+		WantErr: true,
+	},
+	{
+		Name: "hooks of struct and nested structs",
+		Value: &Account{
+			Login:   "ab",
+			Balance: -1,
+			Checked: Checked{Value: -1},
+			History: []*Checked{{Value: 1}, nil, {Value: -2}},
+		},
+		WantErr: true,
+	},
+	{
+		Name:    "recursive struct",
+		Value:   &Node{Value: -1, Next: &Node{Value: -2}},
+		WantErr: true,
+	},
+	{
+		Name: "dates and durations",
+		Value: &Schedule{
+			Start:   time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+			Days:    []time.Time{time.Date(2024, 1, 5, 22, 0, 0, 0, time.UTC), time.Date(2024, 1, 5, 20, 0, 0, 0, time.UTC)},
+			End:     ptr(time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)),
+			Timeout: time.Hour,
+			Retries: []time.Duration{time.Second, 3 * time.Second},
+		},
+		WantErr: true,
+	},
+	{
+		Name: "money",
+		Value: &Transfer{
+			Amount:   "10.005",
+			Fee:      -1,
+			Total:    Cents{},
+			Limit:    &Cents{Units: 1, Exp: 2},
+			Currency: "RUR",
+			Accepted: []string{"USD", "EUR"},
+			Shares:   []Rate{{Coef: big.NewInt(3), Exp: -1}, {Coef: big.NewInt(4), Exp: -1}},
+			Ratio:    -1,
+		},
+		WantErr: true,
+	},
+	{
+		Name: "valid money",
+		Value: &Transfer{
+			Amount:   "1234567890123456.70",
+			Total:    Cents{Units: 100, Exp: -2},
+			Currency: "USD",
+			Shares:   []Rate{{Coef: big.NewInt(1), Exp: -1}},
+		},
+		WantErr: true,
+		Check: func(t T, err error) {
+			// scale is not supported for floats
+			expectEqual(t, []string{"Ratio", "Ratio"}, fieldsOf(errorsOf(t, err)))
+		},
+	},
+	{
+		Name:    "groups",
+		Value:   &AdInput{Text: "too long", Tags: []string{""}, Items: []Item{{Price: -1}}},
+		WantErr: true,
+	},
+	{
+		Name:    "presence",
+		Value:   &Signup{Code: ptr(Title("")), Count: ptr((*int)(nil)), Meta: map[string]string{}, Score: -0.0, Items: []Item{{Price: -1}}},
+		WantErr: true,
+	},
+	{
+		Name:    "required pointers",
+		Value:   &Signup{Age: ptr(0), Count: new(*int)},
+		WantErr: true,
+	},
+	{
+		Name: "valid presence",
+		Value: &Signup{
+			Name:   "bob",
+			Age:    ptr(18),
+			Code:   ptr(Title("a")),
+			Count:  ptr(ptr(-1)),
+			Meta:   map[string]string{"a": ""},
+			Extra:  0,
+			Born:   time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+			Email:  "bob@example.com",
+			Score:  0.1,
+			Agreed: true,
+			Sizes:  []uint8{40},
+			Bad:    "bad",
+		},
+		WantErr: true,
+		Check: func(t T, err error) {
+			// omitempty can't be combined with required
+			expectEqual(t, []string{"Bad"}, fieldsOf(errorsOf(t, err)))
+			expectIs(t, err, validation.ErrInvalidValidatorSyntax)
+		},
+	},
+}
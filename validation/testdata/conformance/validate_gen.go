@@ -0,0 +1,1526 @@
+// Code generated by validategen. DO NOT EDIT.
+
+package conformance
+
+import (
+	"context"
+	"strconv"
+
+	validation "github.com/papey08/golang-fintech/validation"
+	"github.com/papey08/golang-fintech/validation/check"
+	"github.com/papey08/golang-fintech/validation/parse"
+)
+
+func init() {
+	validation.RegisterGenerated((*Account)(nil))
+	validation.RegisterGenerated((*Ad)(nil))
+	validation.RegisterGenerated((*AdInput)(nil))
+	validation.RegisterGenerated((*Collections)(nil))
+	validation.RegisterGenerated((*CrossFields)(nil))
+	validation.RegisterGenerated((*Dive)(nil))
+	validation.RegisterGenerated((*EveryRule)(nil))
+	validation.RegisterGenerated((*Formats)(nil))
+	validation.RegisterGenerated((*IntSlices)(nil))
+	validation.RegisterGenerated((*Interface)(nil))
+	validation.RegisterGenerated((*InvalidSyntax)(nil))
+	validation.RegisterGenerated((*Item)(nil))
+	validation.RegisterGenerated((*Kinds)(nil))
+	validation.RegisterGenerated((*LengthModes)(nil))
+	validation.RegisterGenerated((*NestedStruct)(nil))
+	validation.RegisterGenerated((*Node)(nil))
+	validation.RegisterGenerated((*Schedule)(nil))
+	validation.RegisterGenerated((*SeveralRules)(nil))
+	validation.RegisterGenerated((*Signup)(nil))
+	validation.RegisterGenerated((*Slices)(nil))
+	validation.RegisterGenerated((*StringSlices)(nil))
+	validation.RegisterGenerated((*StructWithNestedStructs)(nil))
+	validation.RegisterGenerated((*Tagged)(nil))
+	validation.RegisterGenerated((*Transfer)(nil))
+	validation.RegisterGenerated((*Unexported)(nil))
+	validation.RegisterGenerated((*UnknownFields)(nil))
+	validation.RegisterGenerated((*UnterminatedQuote)(nil))
+	validation.RegisterGenerated((*ValidSlices)(nil))
+	validation.RegisterGenerated((*WrongFormats)(nil))
+	validation.RegisterGenerated((*WrongIn)(nil))
+	validation.RegisterGenerated((*WrongKinds)(nil))
+	validation.RegisterGenerated((*WrongLength)(nil))
+	validation.RegisterGenerated((*WrongLengthModes)(nil))
+	validation.RegisterGenerated((*WrongMax)(nil))
+	validation.RegisterGenerated((*WrongMin)(nil))
+}
+
+var validateRulesAccount = [...][]parse.Rule{
+	parse.ValidationRules("min:3"), // Login
+}
+
+var validateRulesAd = [...][]parse.Rule{
+	parse.ValidationRules("lenInterval:1,99"),  // Title
+	parse.ValidationRules("lenInterval:1,499"), // Text
+}
+
+var validateRulesAdInput = [...][]parse.Rule{
+	parse.ValidationRules("max:5"), // Text
+}
+
+var validateRulesCollections = [...][]parse.Rule{
+	parse.ValidationRules("max:9"),         // Matrix
+	parse.ValidationRules("len:2"),         // Fixed
+	parse.ValidationRules("min:2"),         // PtrTags
+	parse.ValidationRules("lenmode:bytes"), // Items
+	parse.ValidationRules("min:1"),         // Grid
+	parse.ValidationRules("max:1"),         // Main
+}
+
+var validateRulesEveryRule = [...][]parse.Rule{
+	parse.ValidationRules("min:10;max:2"),        // Title
+	parse.ValidationRules("min:0 in:1,2 max:-1"), // Score
+	parse.ValidationRules("len:abc;in:x"),        // Both
+}
+
+var validateRulesFormats = [...][]parse.Rule{
+	parse.ValidationRules("regexp:^[a-z][a-z0-9_]{2,15}$"), // Login
+	parse.ValidationRules("regexp:'^[a-z]+ [a-z]+$'"),      // Quoted
+	parse.ValidationRules("email"),                         // Email
+	parse.ValidationRules("url"),                           // Site
+	parse.ValidationRules("uuid"),                          // ID
+	parse.ValidationRules("phone"),                         // Phone
+	parse.ValidationRules("alpha"),                         // Name
+	parse.ValidationRules("alnum;len:8"),                   // Account
+	parse.ValidationRules("ascii"),                         // Comment
+	parse.ValidationRules("numeric;len:10"),                // INN
+	parse.ValidationRules("hex"),                           // Hash
+	parse.ValidationRules("alpha"),                         // Tags
+}
+
+var validateRulesIntSlices = [...][]parse.Rule{
+	parse.ValidationRules("min:0"),  // MinNums
+	parse.ValidationRules("max:10"), // MaxNums
+}
+
+var validateRulesInvalidSyntax = [...][]parse.Rule{
+	parse.ValidationRules("len:abcdef"), // Foo
+}
+
+var validateRulesItem = [...][]parse.Rule{
+	parse.ValidationRules("min:1"), // Name
+	parse.ValidationRules("min:0"), // Price
+}
+
+var validateRulesKinds = [...][]parse.Rule{
+	parse.ValidationRules("min:1"),                    // ID
+	parse.ValidationRules("in:-1,0,1"),                // Small
+	parse.ValidationRules("min:-5;max:10"),            // Count
+	parse.ValidationRules("min:18446744073709551615"), // Big
+	parse.ValidationRules("min:0.01;max:99.99"),       // Price
+	parse.ValidationRules("in:0.1,0.2"),               // Rate
+	parse.ValidationRules("min:1;max:99"),             // Title
+	parse.ValidationRules("in:1,2,3"),                 // Code
+	parse.ValidationRules("len:3"),                    // Optional
+	parse.ValidationRules("max:10"),                   // Present
+	parse.ValidationRules("min:1"),                    // IDs
+}
+
+var validateRulesLengthModes = [...][]parse.Rule{
+	parse.ValidationRules("lenInterval:1,99"),        // Title
+	parse.ValidationRules("len:3"),                   // Code
+	parse.ValidationRules("max:6;lenmode:bytes"),     // Bytes
+	parse.ValidationRules("lenmode:graphemes;len:2"), // Emoji
+	parse.ValidationRules("len:7"),                   // EmojiRun
+}
+
+var validateRulesNestedStruct = [...][]parse.Rule{
+	parse.ValidationRules("max:5"), // N
+	parse.ValidationRules("len:3"), // S
+}
+
+var validateRulesSchedule = [...][]parse.Rule{
+	parse.ValidationRules("after:2020-01-01;before:2030-01-01T00:00:00+03:00"), // Start
+	parse.ValidationRules("weekday:sat,sun;tz:Europe/Moscow"),                  // Days
+	parse.ValidationRules("before:now"),                                        // End
+	parse.ValidationRules("min:1s;max:1m"),                                     // Timeout
+	parse.ValidationRules("in:1s,2s"),                                          // Retries
+}
+
+var validateRulesSeveralRules = [...][]parse.Rule{
+	parse.ValidationRules("min:1;max:99"),         // Title
+	parse.ValidationRules("min:1 max:499"),        // Text
+	parse.ValidationRules("len:5;in:draft,ready"), // Status
+	parse.ValidationRules("in:'a,b','c:d';len:3"), // Sep
+	parse.ValidationRules("in:'it\\'s',other"),    // Quote
+	parse.ValidationRules(" min:0 ;; max:100 "),   // Score
+}
+
+var validateRulesSignup = [...][]parse.Rule{
+	parse.ValidationRules("required;max:16"),    // Name
+	parse.ValidationRules("required;min:18"),    // Age
+	parse.ValidationRules("nonzero"),            // Code
+	parse.ValidationRules("nonzero"),            // Count
+	parse.ValidationRules("required"),           // Meta
+	parse.ValidationRules("required"),           // Extra
+	parse.ValidationRules("nonzero"),            // Born
+	parse.ValidationRules("omitempty;email"),    // Email
+	parse.ValidationRules("nonzero"),            // Score
+	parse.ValidationRules("nonzero"),            // Agreed
+	parse.ValidationRules("omitempty"),          // Items
+	parse.ValidationRules("omitempty;max:40"),   // Sizes
+	parse.ValidationRules("omitempty;required"), // Bad
+}
+
+var validateRulesSlices = [...][]parse.Rule{
+	parse.ValidationRules("min:5"),         // ShortStrings
+	parse.ValidationRules("min:5"),         // LongStrings
+	parse.ValidationRules("max:10"),        // SmallNums
+	parse.ValidationRules("max:10"),        // BigNums
+	parse.ValidationRules("in:2,3,5,7,11"), // PrimeNums
+	parse.ValidationRules("in:2,3,5,7,11"), // PiDigits
+}
+
+var validateRulesStringSlices = [...][]parse.Rule{
+	parse.ValidationRules("len:10"), // ShortStrings
+	parse.ValidationRules("len:10"), // LongStrings
+}
+
+var validateRulesTagged = [...][]parse.Rule{
+	parse.ValidationRules("len:20"),         // Len
+	parse.ValidationRules("len:0"),          // LenZ
+	parse.ValidationRules("in:20,25,30"),    // InInt
+	parse.ValidationRules("in:-20,-25,-30"), // InNeg
+	parse.ValidationRules("in:foo,bar"),     // InStr
+	parse.ValidationRules("min:10"),         // MinInt
+	parse.ValidationRules("min:-10"),        // MinIntNeg
+	parse.ValidationRules("min:10"),         // MinStr
+	parse.ValidationRules("min:-1"),         // MinStrNeg
+	parse.ValidationRules("max:20"),         // MaxInt
+	parse.ValidationRules("max:-2"),         // MaxIntNeg
+	parse.ValidationRules("max:20"),         // MaxStr
+}
+
+var validateRulesTransfer = [...][]parse.Rule{
+	parse.ValidationRules("positive;scale:2;precision:18"),      // Amount
+	parse.ValidationRules("decmin:0;decmax:10000"),              // Fee
+	parse.ValidationRules("positive;scale:2;decmax:1000000.00"), // Total
+	parse.ValidationRules("min:0.01;max:99.99"),                 // Limit
+	parse.ValidationRules("currency:ISO4217"),                   // Currency
+	parse.ValidationRules("currency:RUB,USD"),                   // Accepted
+	parse.ValidationRules("decmin:0.1;decmax:0.3"),              // Shares
+	parse.ValidationRules("positive;scale:2"),                   // Ratio
+}
+
+var validateRulesValidSlices = [...][]parse.Rule{
+	parse.ValidationRules("max:5"),                  // ShortStrings
+	parse.ValidationRules("min:5"),                  // LongStrings
+	parse.ValidationRules("max:10"),                 // SmallNums
+	parse.ValidationRules("min:10"),                 // BigNums
+	parse.ValidationRules("in:2,3,5,7,11"),          // PrimeNums
+	parse.ValidationRules("in:0,1,2,3,4,5,6,7,8,9"), // PiDigits
+}
+
+var validateRulesWrongFormats = [...][]parse.Rule{
+	parse.ValidationRules("regexp:^[a-z]{2,4}$"), // Login
+	parse.ValidationRules("email"),               // Email
+	parse.ValidationRules("email"),               // Named
+	parse.ValidationRules("url"),                 // Site
+	parse.ValidationRules("uuid"),                // ID
+	parse.ValidationRules("phone"),               // Phone
+	parse.ValidationRules("alpha"),               // Name
+	parse.ValidationRules("alnum"),               // Account
+	parse.ValidationRules("ascii"),               // Comment
+	parse.ValidationRules("numeric"),             // INN
+	parse.ValidationRules("hex"),                 // Hash
+	parse.ValidationRules("regexp:a(b"),          // BadRe
+	parse.ValidationRules("email:strict"),        // BadArgs
+	parse.ValidationRules("numeric"),             // Number
+}
+
+var validateRulesWrongIn = [...][]parse.Rule{
+	parse.ValidationRules("in:ab,cd"),       // InA
+	parse.ValidationRules("in:aa,bb,cd,ee"), // InB
+	parse.ValidationRules("in:-1,-3,5,7"),   // InC
+	parse.ValidationRules("in:5-"),          // InD
+	parse.ValidationRules("in:"),            // InEmpty
+}
+
+var validateRulesWrongKinds = [...][]parse.Rule{
+	parse.ValidationRules("min:1"),    // ID
+	parse.ValidationRules("max:-1"),   // Count
+	parse.ValidationRules("min:0.01"), // Price
+	parse.ValidationRules("max:3"),    // Title
+	parse.ValidationRules("in:1,2,3"), // Code
+	parse.ValidationRules("len:3"),    // Present
+	parse.ValidationRules("in:1000"),  // Small
+	parse.ValidationRules("len:3"),    // Len
+	parse.ValidationRules("in:true"),  // Flag
+	parse.ValidationRules("min:1"),    // Date
+}
+
+var validateRulesWrongLength = [...][]parse.Rule{
+	parse.ValidationRules("len:24"),  // Lower
+	parse.ValidationRules("len:5"),   // Higher
+	parse.ValidationRules("len:3"),   // Zero
+	parse.ValidationRules("len:%12"), // BadSpec
+	parse.ValidationRules("len:-6"),  // Negative
+}
+
+var validateRulesWrongLengthModes = [...][]parse.Rule{
+	parse.ValidationRules("lenmode:bytes;lenInterval:1,99"), // Title
+	parse.ValidationRules("lenmode:graphemes;max:1"),        // Emoji
+	parse.ValidationRules("lenmode:chars"),                  // Mode
+	parse.ValidationRules("lenmode:bytes;lenmode:runes"),    // Twice
+	parse.ValidationRules("lenmode:bytes;max:5"),            // Number
+}
+
+var validateRulesWrongMax = [...][]parse.Rule{
+	parse.ValidationRules("max:2"),   // MaxA
+	parse.ValidationRules("max:-7"),  // MaxB
+	parse.ValidationRules("max:-12"), // MaxC
+	parse.ValidationRules("max:5-"),  // MaxD
+	parse.ValidationRules("max:"),    // MaxE
+	parse.ValidationRules("max:"),    // MaxF
+}
+
+var validateRulesWrongMin = [...][]parse.Rule{
+	parse.ValidationRules("min:12"),  // MinA
+	parse.ValidationRules("min:-12"), // MinB
+	parse.ValidationRules("min:5-"),  // MinC
+	parse.ValidationRules("min:"),    // MinD
+	parse.ValidationRules("min:"),    // MinE
+}
+
+// Validate checks fields of Account according to their validate tags
+func (x *Account) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// Login
+	if err := check.ValidString(string(x.Login), validateRulesAccount[0][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Login", Rule: validateRulesAccount[0][0].Name, Args: validateRulesAccount[0][0].Params, Value: x.Login, Err: err})
+	}
+	// Checked
+	errs = append(errs, validation.PrefixErrors("Checked", x.Checked.Validate())...)
+	// History
+	for i1 := range x.History {
+		if p2 := x.History[i1]; p2 != nil {
+			errs = append(errs, validation.PrefixErrors("History["+strconv.Itoa(i1)+"]", (*p2).Validate())...)
+		}
+	}
+	errs = append(errs, validation.PrefixErrors("", x.ValidateContext(context.Background()))...)
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of Ad according to their validate tags
+func (x *Ad) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// Title
+	if err := check.ValidString(string(x.Title), validateRulesAd[0][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Title", Rule: validateRulesAd[0][0].Name, Args: validateRulesAd[0][0].Params, Value: x.Title, Err: err})
+	}
+	// Text
+	if err := check.ValidString(string(x.Text), validateRulesAd[1][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Text", Rule: validateRulesAd[1][0].Name, Args: validateRulesAd[1][0].Params, Value: x.Text, Err: err})
+	}
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of AdInput according to their validate tags
+func (x *AdInput) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// Text
+	if err := check.ValidString(string(x.Text), validateRulesAdInput[0][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Text", Rule: validateRulesAdInput[0][0].Name, Args: validateRulesAdInput[0][0].Params, Value: x.Text, Err: err})
+	}
+	// Items
+	for i1 := range x.Items {
+		errs = append(errs, validation.PrefixErrors("Items["+strconv.Itoa(i1)+"]", x.Items[i1].Validate())...)
+	}
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of Collections according to their validate tags
+func (x *Collections) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// Matrix
+rule1:
+	for i1 := range x.Matrix {
+		for i2 := range x.Matrix[i1] {
+			if err := check.ValidInt(int64(x.Matrix[i1][i2]), strconv.IntSize, validateRulesCollections[0][0]); err != nil {
+				errs = append(errs, validation.ValidationError{Field: "Matrix[" + strconv.Itoa(i1) + "][" + strconv.Itoa(i2) + "]", Rule: validateRulesCollections[0][0].Name, Args: validateRulesCollections[0][0].Params, Value: x.Matrix[i1][i2], Err: err})
+				break rule1
+			}
+		}
+	}
+	// Fixed
+rule2:
+	for i1 := range x.Fixed {
+		if p2 := x.Fixed[i1]; p2 != nil {
+			if err := check.ValidString(string(*p2), validateRulesCollections[1][0]); err != nil {
+				errs = append(errs, validation.ValidationError{Field: "Fixed[" + strconv.Itoa(i1) + "]", Rule: validateRulesCollections[1][0].Name, Args: validateRulesCollections[1][0].Params, Value: *p2, Err: err})
+				break rule2
+			}
+		}
+	}
+	// PtrTags
+	if p1 := x.PtrTags; p1 != nil {
+	rule3:
+		for i2 := range *p1 {
+			if err := check.ValidString(string((*p1)[i2]), validateRulesCollections[2][0]); err != nil {
+				errs = append(errs, validation.ValidationError{Field: "PtrTags[" + strconv.Itoa(i2) + "]", Rule: validateRulesCollections[2][0].Name, Args: validateRulesCollections[2][0].Params, Value: (*p1)[i2], Err: err})
+				break rule3
+			}
+		}
+	}
+	// Items
+	for i1 := range x.Items {
+		errs = append(errs, validation.PrefixErrors("Items["+strconv.Itoa(i1)+"]", x.Items[i1].Validate())...)
+	}
+	// ByPtr
+	for i1 := range x.ByPtr {
+		if p2 := x.ByPtr[i1]; p2 != nil {
+			errs = append(errs, validation.PrefixErrors("ByPtr["+strconv.Itoa(i1)+"]", (*p2).Validate())...)
+		}
+	}
+	// Grid
+rule4:
+	for i1 := range x.Grid {
+		for i2 := range x.Grid[i1] {
+			errs = append(errs, validation.ValidationError{Field: "Grid[" + strconv.Itoa(i1) + "][" + strconv.Itoa(i2) + "]", Rule: validateRulesCollections[4][0].Name, Args: validateRulesCollections[4][0].Params, Value: x.Grid[i1][i2], Err: check.ErrInvalidFieldType})
+			break rule4
+		}
+	}
+	for i1 := range x.Grid {
+		for i2 := range x.Grid[i1] {
+			errs = append(errs, validation.PrefixErrors("Grid["+strconv.Itoa(i1)+"]["+strconv.Itoa(i2)+"]", x.Grid[i1][i2].Validate())...)
+		}
+	}
+	// Main
+	if p1 := x.Main; p1 != nil {
+		errs = append(errs, validation.ValidationError{Field: "Main", Rule: validateRulesCollections[5][0].Name, Args: validateRulesCollections[5][0].Params, Value: *p1, Err: check.ErrInvalidFieldType})
+	}
+	if p1 := x.Main; p1 != nil {
+		errs = append(errs, validation.PrefixErrors("Main", (*p1).Validate())...)
+	}
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of CrossFields according to their validate tags. It
+// calls validation.Validate since field DateTo has cross-field rule gtfield
+func (x *CrossFields) Validate() error {
+	return validation.Validate(x)
+}
+
+// Validate checks fields of Dive according to their validate tags. It
+// calls validation.Validate since field Tags has rule dive
+func (x *Dive) Validate() error {
+	return validation.Validate(x)
+}
+
+// Validate checks fields of EveryRule according to their validate tags
+func (x *EveryRule) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// Title
+	if err := check.ValidString(string(x.Title), validateRulesEveryRule[0][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Title", Rule: validateRulesEveryRule[0][0].Name, Args: validateRulesEveryRule[0][0].Params, Value: x.Title, Err: err})
+	}
+	if err := check.ValidString(string(x.Title), validateRulesEveryRule[0][1]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Title", Rule: validateRulesEveryRule[0][1].Name, Args: validateRulesEveryRule[0][1].Params, Value: x.Title, Err: err})
+	}
+	// Score
+	if err := check.ValidInt(int64(x.Score), strconv.IntSize, validateRulesEveryRule[1][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Score", Rule: validateRulesEveryRule[1][0].Name, Args: validateRulesEveryRule[1][0].Params, Value: x.Score, Err: err})
+	}
+	if err := check.ValidInt(int64(x.Score), strconv.IntSize, validateRulesEveryRule[1][1]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Score", Rule: validateRulesEveryRule[1][1].Name, Args: validateRulesEveryRule[1][1].Params, Value: x.Score, Err: err})
+	}
+	if err := check.ValidInt(int64(x.Score), strconv.IntSize, validateRulesEveryRule[1][2]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Score", Rule: validateRulesEveryRule[1][2].Name, Args: validateRulesEveryRule[1][2].Params, Value: x.Score, Err: err})
+	}
+	// Both
+	errs = append(errs, validation.ValidationError{Field: "Both", Rule: validateRulesEveryRule[2][0].Name, Args: validateRulesEveryRule[2][0].Params, Err: validation.ErrInvalidValidatorSyntax})
+	if err := check.ValidString(string(x.Both), validateRulesEveryRule[2][1]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Both", Rule: validateRulesEveryRule[2][1].Name, Args: validateRulesEveryRule[2][1].Params, Value: x.Both, Err: err})
+	}
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of Formats according to their validate tags
+func (x *Formats) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// Login
+	if err := check.ValidString(string(x.Login), validateRulesFormats[0][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Login", Rule: validateRulesFormats[0][0].Name, Args: validateRulesFormats[0][0].Params, Value: x.Login, Err: err})
+	}
+	// Quoted
+	if err := check.ValidString(string(x.Quoted), validateRulesFormats[1][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Quoted", Rule: validateRulesFormats[1][0].Name, Args: validateRulesFormats[1][0].Params, Value: x.Quoted, Err: err})
+	}
+	// Email
+	if err := check.ValidString(string(x.Email), validateRulesFormats[2][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Email", Rule: validateRulesFormats[2][0].Name, Args: validateRulesFormats[2][0].Params, Value: x.Email, Err: err})
+	}
+	// Site
+	if err := check.ValidString(string(x.Site), validateRulesFormats[3][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Site", Rule: validateRulesFormats[3][0].Name, Args: validateRulesFormats[3][0].Params, Value: x.Site, Err: err})
+	}
+	// ID
+	if err := check.ValidString(string(x.ID), validateRulesFormats[4][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "ID", Rule: validateRulesFormats[4][0].Name, Args: validateRulesFormats[4][0].Params, Value: x.ID, Err: err})
+	}
+	// Phone
+	if err := check.ValidString(string(x.Phone), validateRulesFormats[5][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Phone", Rule: validateRulesFormats[5][0].Name, Args: validateRulesFormats[5][0].Params, Value: x.Phone, Err: err})
+	}
+	// Name
+	if err := check.ValidString(string(x.Name), validateRulesFormats[6][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Name", Rule: validateRulesFormats[6][0].Name, Args: validateRulesFormats[6][0].Params, Value: x.Name, Err: err})
+	}
+	// Account
+	if err := check.ValidString(string(x.Account), validateRulesFormats[7][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Account", Rule: validateRulesFormats[7][0].Name, Args: validateRulesFormats[7][0].Params, Value: x.Account, Err: err})
+	}
+	if err := check.ValidString(string(x.Account), validateRulesFormats[7][1]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Account", Rule: validateRulesFormats[7][1].Name, Args: validateRulesFormats[7][1].Params, Value: x.Account, Err: err})
+	}
+	// Comment
+	if err := check.ValidString(string(x.Comment), validateRulesFormats[8][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Comment", Rule: validateRulesFormats[8][0].Name, Args: validateRulesFormats[8][0].Params, Value: x.Comment, Err: err})
+	}
+	// INN
+	if err := check.ValidString(string(x.INN), validateRulesFormats[9][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "INN", Rule: validateRulesFormats[9][0].Name, Args: validateRulesFormats[9][0].Params, Value: x.INN, Err: err})
+	}
+	if err := check.ValidString(string(x.INN), validateRulesFormats[9][1]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "INN", Rule: validateRulesFormats[9][1].Name, Args: validateRulesFormats[9][1].Params, Value: x.INN, Err: err})
+	}
+	// Hash
+	if err := check.ValidString(string(x.Hash), validateRulesFormats[10][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Hash", Rule: validateRulesFormats[10][0].Name, Args: validateRulesFormats[10][0].Params, Value: x.Hash, Err: err})
+	}
+	// Tags
+rule1:
+	for i1 := range x.Tags {
+		if err := check.ValidString(string(x.Tags[i1]), validateRulesFormats[11][0]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "Tags[" + strconv.Itoa(i1) + "]", Rule: validateRulesFormats[11][0].Name, Args: validateRulesFormats[11][0].Params, Value: x.Tags[i1], Err: err})
+			break rule1
+		}
+	}
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of IntSlices according to their validate tags
+func (x *IntSlices) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// MinNums
+rule1:
+	for i1 := range x.MinNums {
+		if err := check.ValidInt(int64(x.MinNums[i1]), strconv.IntSize, validateRulesIntSlices[0][0]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "MinNums[" + strconv.Itoa(i1) + "]", Rule: validateRulesIntSlices[0][0].Name, Args: validateRulesIntSlices[0][0].Params, Value: x.MinNums[i1], Err: err})
+			break rule1
+		}
+	}
+	// MaxNums
+rule2:
+	for i1 := range x.MaxNums {
+		if err := check.ValidInt(int64(x.MaxNums[i1]), strconv.IntSize, validateRulesIntSlices[1][0]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "MaxNums[" + strconv.Itoa(i1) + "]", Rule: validateRulesIntSlices[1][0].Name, Args: validateRulesIntSlices[1][0].Params, Value: x.MaxNums[i1], Err: err})
+			break rule2
+		}
+	}
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of Interface according to their validate tags. It
+// calls validation.Validate since rules of field Any are applied to map or interface
+func (x *Interface) Validate() error {
+	return validation.Validate(x)
+}
+
+// Validate checks fields of InvalidSyntax according to their validate tags
+func (x *InvalidSyntax) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// Foo
+	errs = append(errs, validation.ValidationError{Field: "Foo", Rule: validateRulesInvalidSyntax[0][0].Name, Args: validateRulesInvalidSyntax[0][0].Params, Err: validation.ErrInvalidValidatorSyntax})
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of Item according to their validate tags
+func (x *Item) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// Name
+	if err := check.ValidString(string(x.Name), validateRulesItem[0][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Name", Label: "Item name", Rule: validateRulesItem[0][0].Name, Args: validateRulesItem[0][0].Params, Value: x.Name, Err: err})
+	}
+	// Price
+	if err := check.ValidInt(int64(x.Price), strconv.IntSize, validateRulesItem[1][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Price", Rule: validateRulesItem[1][0].Name, Args: validateRulesItem[1][0].Params, Value: x.Price, Err: err})
+	}
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of Kinds according to their validate tags
+func (x *Kinds) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// ID
+	if err := check.ValidInt(int64(x.ID), 64, validateRulesKinds[0][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "ID", Rule: validateRulesKinds[0][0].Name, Args: validateRulesKinds[0][0].Params, Value: x.ID, Err: err})
+	}
+	// Small
+	if err := check.ValidInt(int64(x.Small), 8, validateRulesKinds[1][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Small", Rule: validateRulesKinds[1][0].Name, Args: validateRulesKinds[1][0].Params, Value: x.Small, Err: err})
+	}
+	// Count
+	if err := check.ValidUint(uint64(x.Count), 32, validateRulesKinds[2][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Count", Rule: validateRulesKinds[2][0].Name, Args: validateRulesKinds[2][0].Params, Value: x.Count, Err: err})
+	}
+	if err := check.ValidUint(uint64(x.Count), 32, validateRulesKinds[2][1]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Count", Rule: validateRulesKinds[2][1].Name, Args: validateRulesKinds[2][1].Params, Value: x.Count, Err: err})
+	}
+	// Big
+	if err := check.ValidUint(uint64(x.Big), 64, validateRulesKinds[3][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Big", Rule: validateRulesKinds[3][0].Name, Args: validateRulesKinds[3][0].Params, Value: x.Big, Err: err})
+	}
+	// Price
+	if err := check.ValidFloat(float64(x.Price), 64, validateRulesKinds[4][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Price", Rule: validateRulesKinds[4][0].Name, Args: validateRulesKinds[4][0].Params, Value: x.Price, Err: err})
+	}
+	if err := check.ValidFloat(float64(x.Price), 64, validateRulesKinds[4][1]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Price", Rule: validateRulesKinds[4][1].Name, Args: validateRulesKinds[4][1].Params, Value: x.Price, Err: err})
+	}
+	// Rate
+	if err := check.ValidFloat(float64(x.Rate), 32, validateRulesKinds[5][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Rate", Rule: validateRulesKinds[5][0].Name, Args: validateRulesKinds[5][0].Params, Value: x.Rate, Err: err})
+	}
+	// Title
+	if err := check.ValidString(string(x.Title), validateRulesKinds[6][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Title", Rule: validateRulesKinds[6][0].Name, Args: validateRulesKinds[6][0].Params, Value: x.Title, Err: err})
+	}
+	if err := check.ValidString(string(x.Title), validateRulesKinds[6][1]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Title", Rule: validateRulesKinds[6][1].Name, Args: validateRulesKinds[6][1].Params, Value: x.Title, Err: err})
+	}
+	// Code
+	if err := check.ValidUint(uint64(x.Code), 8, validateRulesKinds[7][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Code", Rule: validateRulesKinds[7][0].Name, Args: validateRulesKinds[7][0].Params, Value: x.Code, Err: err})
+	}
+	// Optional
+	if p1 := x.Optional; p1 != nil {
+		if err := check.ValidString(string(*p1), validateRulesKinds[8][0]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "Optional", Rule: validateRulesKinds[8][0].Name, Args: validateRulesKinds[8][0].Params, Value: *p1, Err: err})
+		}
+	}
+	// Present
+	if p1 := x.Present; p1 != nil {
+		if err := check.ValidInt(int64(*p1), 64, validateRulesKinds[9][0]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "Present", Rule: validateRulesKinds[9][0].Name, Args: validateRulesKinds[9][0].Params, Value: *p1, Err: err})
+		}
+	}
+	// IDs
+rule1:
+	for i1 := range x.IDs {
+		if err := check.ValidInt(int64(x.IDs[i1]), 64, validateRulesKinds[10][0]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "IDs[" + strconv.Itoa(i1) + "]", Rule: validateRulesKinds[10][0].Name, Args: validateRulesKinds[10][0].Params, Value: x.IDs[i1], Err: err})
+			break rule1
+		}
+	}
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of LengthModes according to their validate tags
+func (x *LengthModes) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// Title
+	if err := check.ValidString(string(x.Title), validateRulesLengthModes[0][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Title", Rule: validateRulesLengthModes[0][0].Name, Args: validateRulesLengthModes[0][0].Params, Value: x.Title, Err: err})
+	}
+	// Code
+	if err := check.ValidString(string(x.Code), validateRulesLengthModes[1][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Code", Rule: validateRulesLengthModes[1][0].Name, Args: validateRulesLengthModes[1][0].Params, Value: x.Code, Err: err})
+	}
+	// Bytes
+	if err := check.ValidString(string(x.Bytes), validateRulesLengthModes[2][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Bytes", Rule: validateRulesLengthModes[2][0].Name, Args: validateRulesLengthModes[2][0].Params, Value: x.Bytes, Err: err})
+	}
+	// Emoji
+	if err := check.ValidString(string(x.Emoji), validateRulesLengthModes[3][1]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Emoji", Rule: validateRulesLengthModes[3][1].Name, Args: validateRulesLengthModes[3][1].Params, Value: x.Emoji, Err: err})
+	}
+	// EmojiRun
+	if err := check.ValidString(string(x.EmojiRun), validateRulesLengthModes[4][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "EmojiRun", Rule: validateRulesLengthModes[4][0].Name, Args: validateRulesLengthModes[4][0].Params, Value: x.EmojiRun, Err: err})
+	}
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of NestedStruct according to their validate tags
+func (x *NestedStruct) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// N
+	if err := check.ValidInt(int64(x.N), strconv.IntSize, validateRulesNestedStruct[0][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "N", Rule: validateRulesNestedStruct[0][0].Name, Args: validateRulesNestedStruct[0][0].Params, Value: x.N, Err: err})
+	}
+	// S
+	if err := check.ValidString(string(x.S), validateRulesNestedStruct[1][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "S", Rule: validateRulesNestedStruct[1][0].Name, Args: validateRulesNestedStruct[1][0].Params, Value: x.S, Err: err})
+	}
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of Node according to their validate tags. It
+// calls validation.Validate since type is recursive
+func (x *Node) Validate() error {
+	return validation.Validate(x)
+}
+
+// Validate checks fields of Schedule according to their validate tags
+func (x *Schedule) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// Start
+	if err := check.ValidTime(x.Start, validateRulesSchedule[0][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Start", Rule: validateRulesSchedule[0][0].Name, Args: validateRulesSchedule[0][0].Params, Value: x.Start, Err: err})
+	}
+	if err := check.ValidTime(x.Start, validateRulesSchedule[0][1]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Start", Rule: validateRulesSchedule[0][1].Name, Args: validateRulesSchedule[0][1].Params, Value: x.Start, Err: err})
+	}
+	// Days
+rule1:
+	for i1 := range x.Days {
+		if err := check.ValidTime(x.Days[i1], validateRulesSchedule[1][0]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "Days[" + strconv.Itoa(i1) + "]", Rule: validateRulesSchedule[1][0].Name, Args: validateRulesSchedule[1][0].Params, Value: x.Days[i1], Err: err})
+			break rule1
+		}
+	}
+	// End
+	if p1 := x.End; p1 != nil {
+		if err := check.ValidTime(*p1, validateRulesSchedule[2][0]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "End", Rule: validateRulesSchedule[2][0].Name, Args: validateRulesSchedule[2][0].Params, Value: *p1, Err: err})
+		}
+	}
+	// Timeout
+	if err := check.ValidDuration(x.Timeout, validateRulesSchedule[3][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Timeout", Rule: validateRulesSchedule[3][0].Name, Args: validateRulesSchedule[3][0].Params, Value: x.Timeout, Err: err})
+	}
+	if err := check.ValidDuration(x.Timeout, validateRulesSchedule[3][1]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Timeout", Rule: validateRulesSchedule[3][1].Name, Args: validateRulesSchedule[3][1].Params, Value: x.Timeout, Err: err})
+	}
+	// Retries
+rule2:
+	for i1 := range x.Retries {
+		if err := check.ValidDuration(x.Retries[i1], validateRulesSchedule[4][0]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "Retries[" + strconv.Itoa(i1) + "]", Rule: validateRulesSchedule[4][0].Name, Args: validateRulesSchedule[4][0].Params, Value: x.Retries[i1], Err: err})
+			break rule2
+		}
+	}
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of SeveralRules according to their validate tags
+func (x *SeveralRules) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// Title
+	if err := check.ValidString(string(x.Title), validateRulesSeveralRules[0][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Title", Rule: validateRulesSeveralRules[0][0].Name, Args: validateRulesSeveralRules[0][0].Params, Value: x.Title, Err: err})
+	}
+	if err := check.ValidString(string(x.Title), validateRulesSeveralRules[0][1]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Title", Rule: validateRulesSeveralRules[0][1].Name, Args: validateRulesSeveralRules[0][1].Params, Value: x.Title, Err: err})
+	}
+	// Text
+	if err := check.ValidString(string(x.Text), validateRulesSeveralRules[1][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Text", Rule: validateRulesSeveralRules[1][0].Name, Args: validateRulesSeveralRules[1][0].Params, Value: x.Text, Err: err})
+	}
+	if err := check.ValidString(string(x.Text), validateRulesSeveralRules[1][1]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Text", Rule: validateRulesSeveralRules[1][1].Name, Args: validateRulesSeveralRules[1][1].Params, Value: x.Text, Err: err})
+	}
+	// Status
+	if err := check.ValidString(string(x.Status), validateRulesSeveralRules[2][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Status", Rule: validateRulesSeveralRules[2][0].Name, Args: validateRulesSeveralRules[2][0].Params, Value: x.Status, Err: err})
+	}
+	if err := check.ValidString(string(x.Status), validateRulesSeveralRules[2][1]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Status", Rule: validateRulesSeveralRules[2][1].Name, Args: validateRulesSeveralRules[2][1].Params, Value: x.Status, Err: err})
+	}
+	// Sep
+	if err := check.ValidString(string(x.Sep), validateRulesSeveralRules[3][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Sep", Rule: validateRulesSeveralRules[3][0].Name, Args: validateRulesSeveralRules[3][0].Params, Value: x.Sep, Err: err})
+	}
+	if err := check.ValidString(string(x.Sep), validateRulesSeveralRules[3][1]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Sep", Rule: validateRulesSeveralRules[3][1].Name, Args: validateRulesSeveralRules[3][1].Params, Value: x.Sep, Err: err})
+	}
+	// Quote
+	if err := check.ValidString(string(x.Quote), validateRulesSeveralRules[4][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Quote", Rule: validateRulesSeveralRules[4][0].Name, Args: validateRulesSeveralRules[4][0].Params, Value: x.Quote, Err: err})
+	}
+	// Score
+	if err := check.ValidInt(int64(x.Score), strconv.IntSize, validateRulesSeveralRules[5][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Score", Rule: validateRulesSeveralRules[5][0].Name, Args: validateRulesSeveralRules[5][0].Params, Value: x.Score, Err: err})
+	}
+	if err := check.ValidInt(int64(x.Score), strconv.IntSize, validateRulesSeveralRules[5][1]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Score", Rule: validateRulesSeveralRules[5][1].Name, Args: validateRulesSeveralRules[5][1].Params, Value: x.Score, Err: err})
+	}
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of Signup according to their validate tags
+func (x *Signup) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// Name
+	if len(x.Name) == 0 {
+		errs = append(errs, validation.ValidationError{Field: "Name", Rule: validateRulesSignup[0][0].Name, Args: validateRulesSignup[0][0].Params, Value: x.Name, Err: check.ErrInvalidFieldValue})
+	}
+	if err := check.ValidString(string(x.Name), validateRulesSignup[0][1]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Name", Rule: validateRulesSignup[0][1].Name, Args: validateRulesSignup[0][1].Params, Value: x.Name, Err: err})
+	}
+	// Age
+	if x.Age == nil {
+		errs = append(errs, validation.ValidationError{Field: "Age", Rule: validateRulesSignup[1][0].Name, Args: validateRulesSignup[1][0].Params, Err: check.ErrInvalidFieldValue})
+	}
+	if p1 := x.Age; p1 != nil {
+		if err := check.ValidInt(int64(*p1), strconv.IntSize, validateRulesSignup[1][1]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "Age", Rule: validateRulesSignup[1][1].Name, Args: validateRulesSignup[1][1].Params, Value: *p1, Err: err})
+		}
+	}
+	// Code
+	if p1 := x.Code; p1 == nil {
+		errs = append(errs, validation.ValidationError{Field: "Code", Rule: validateRulesSignup[2][0].Name, Args: validateRulesSignup[2][0].Params, Err: check.ErrInvalidFieldValue})
+	} else {
+		if len(*p1) == 0 {
+			errs = append(errs, validation.ValidationError{Field: "Code", Rule: validateRulesSignup[2][0].Name, Args: validateRulesSignup[2][0].Params, Value: *p1, Err: check.ErrInvalidFieldValue})
+		}
+	}
+	// Count
+	if p1 := x.Count; p1 == nil {
+		errs = append(errs, validation.ValidationError{Field: "Count", Rule: validateRulesSignup[3][0].Name, Args: validateRulesSignup[3][0].Params, Err: check.ErrInvalidFieldValue})
+	} else {
+		if p2 := *p1; p2 == nil {
+			errs = append(errs, validation.ValidationError{Field: "Count", Rule: validateRulesSignup[3][0].Name, Args: validateRulesSignup[3][0].Params, Err: check.ErrInvalidFieldValue})
+		} else {
+			if *p2 == 0 {
+				errs = append(errs, validation.ValidationError{Field: "Count", Rule: validateRulesSignup[3][0].Name, Args: validateRulesSignup[3][0].Params, Value: *p2, Err: check.ErrInvalidFieldValue})
+			}
+		}
+	}
+	// Meta
+	if len(x.Meta) == 0 {
+		errs = append(errs, validation.ValidationError{Field: "Meta", Rule: validateRulesSignup[4][0].Name, Args: validateRulesSignup[4][0].Params, Value: x.Meta, Err: check.ErrInvalidFieldValue})
+	}
+	// Extra
+	if x.Extra == nil {
+		errs = append(errs, validation.ValidationError{Field: "Extra", Rule: validateRulesSignup[5][0].Name, Args: validateRulesSignup[5][0].Params, Err: check.ErrInvalidFieldValue})
+	}
+	// Born
+	if x.Born.IsZero() {
+		errs = append(errs, validation.ValidationError{Field: "Born", Rule: validateRulesSignup[6][0].Name, Args: validateRulesSignup[6][0].Params, Value: x.Born, Err: check.ErrInvalidFieldValue})
+	}
+	// Email
+	if !(len(x.Email) == 0) {
+		if err := check.ValidString(string(x.Email), validateRulesSignup[7][1]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "Email", Rule: validateRulesSignup[7][1].Name, Args: validateRulesSignup[7][1].Params, Value: x.Email, Err: err})
+		}
+	}
+	// Score
+	if x.Score == 0 {
+		errs = append(errs, validation.ValidationError{Field: "Score", Rule: validateRulesSignup[8][0].Name, Args: validateRulesSignup[8][0].Params, Value: x.Score, Err: check.ErrInvalidFieldValue})
+	}
+	// Agreed
+	if !x.Agreed {
+		errs = append(errs, validation.ValidationError{Field: "Agreed", Rule: validateRulesSignup[9][0].Name, Args: validateRulesSignup[9][0].Params, Value: x.Agreed, Err: check.ErrInvalidFieldValue})
+	}
+	// Items
+	if !(len(x.Items) == 0) {
+		for i1 := range x.Items {
+			errs = append(errs, validation.PrefixErrors("Items["+strconv.Itoa(i1)+"]", x.Items[i1].Validate())...)
+		}
+	}
+	// Sizes
+	if !(len(x.Sizes) == 0) {
+	rule1:
+		for i1 := range x.Sizes {
+			if err := check.ValidUint(uint64(x.Sizes[i1]), 8, validateRulesSignup[11][1]); err != nil {
+				errs = append(errs, validation.ValidationError{Field: "Sizes[" + strconv.Itoa(i1) + "]", Rule: validateRulesSignup[11][1].Name, Args: validateRulesSignup[11][1].Params, Value: x.Sizes[i1], Err: err})
+				break rule1
+			}
+		}
+	}
+	// Bad
+	errs = append(errs, validation.ValidationError{Field: "Bad", Rule: validateRulesSignup[12][0].Name, Args: validateRulesSignup[12][0].Params, Err: validation.ErrInvalidValidatorSyntax})
+	if len(x.Bad) == 0 {
+		errs = append(errs, validation.ValidationError{Field: "Bad", Rule: validateRulesSignup[12][1].Name, Args: validateRulesSignup[12][1].Params, Value: x.Bad, Err: check.ErrInvalidFieldValue})
+	}
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of Slices according to their validate tags
+func (x *Slices) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// ShortStrings
+rule1:
+	for i1 := range x.ShortStrings {
+		if err := check.ValidString(string(x.ShortStrings[i1]), validateRulesSlices[0][0]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "ShortStrings[" + strconv.Itoa(i1) + "]", Rule: validateRulesSlices[0][0].Name, Args: validateRulesSlices[0][0].Params, Value: x.ShortStrings[i1], Err: err})
+			break rule1
+		}
+	}
+	// LongStrings
+rule2:
+	for i1 := range x.LongStrings {
+		if err := check.ValidString(string(x.LongStrings[i1]), validateRulesSlices[1][0]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "LongStrings[" + strconv.Itoa(i1) + "]", Rule: validateRulesSlices[1][0].Name, Args: validateRulesSlices[1][0].Params, Value: x.LongStrings[i1], Err: err})
+			break rule2
+		}
+	}
+	// SmallNums
+rule3:
+	for i1 := range x.SmallNums {
+		if err := check.ValidInt(int64(x.SmallNums[i1]), strconv.IntSize, validateRulesSlices[2][0]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "SmallNums[" + strconv.Itoa(i1) + "]", Rule: validateRulesSlices[2][0].Name, Args: validateRulesSlices[2][0].Params, Value: x.SmallNums[i1], Err: err})
+			break rule3
+		}
+	}
+	// BigNums
+rule4:
+	for i1 := range x.BigNums {
+		if err := check.ValidInt(int64(x.BigNums[i1]), strconv.IntSize, validateRulesSlices[3][0]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "BigNums[" + strconv.Itoa(i1) + "]", Rule: validateRulesSlices[3][0].Name, Args: validateRulesSlices[3][0].Params, Value: x.BigNums[i1], Err: err})
+			break rule4
+		}
+	}
+	// PrimeNums
+rule5:
+	for i1 := range x.PrimeNums {
+		if err := check.ValidInt(int64(x.PrimeNums[i1]), strconv.IntSize, validateRulesSlices[4][0]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "PrimeNums[" + strconv.Itoa(i1) + "]", Rule: validateRulesSlices[4][0].Name, Args: validateRulesSlices[4][0].Params, Value: x.PrimeNums[i1], Err: err})
+			break rule5
+		}
+	}
+	// PiDigits
+rule6:
+	for i1 := range x.PiDigits {
+		if err := check.ValidInt(int64(x.PiDigits[i1]), strconv.IntSize, validateRulesSlices[5][0]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "PiDigits[" + strconv.Itoa(i1) + "]", Rule: validateRulesSlices[5][0].Name, Args: validateRulesSlices[5][0].Params, Value: x.PiDigits[i1], Err: err})
+			break rule6
+		}
+	}
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of StringSlices according to their validate tags
+func (x *StringSlices) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// ShortStrings
+rule1:
+	for i1 := range x.ShortStrings {
+		if err := check.ValidString(string(x.ShortStrings[i1]), validateRulesStringSlices[0][0]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "ShortStrings[" + strconv.Itoa(i1) + "]", Rule: validateRulesStringSlices[0][0].Name, Args: validateRulesStringSlices[0][0].Params, Value: x.ShortStrings[i1], Err: err})
+			break rule1
+		}
+	}
+	// LongStrings
+rule2:
+	for i1 := range x.LongStrings {
+		if err := check.ValidString(string(x.LongStrings[i1]), validateRulesStringSlices[1][0]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "LongStrings[" + strconv.Itoa(i1) + "]", Rule: validateRulesStringSlices[1][0].Name, Args: validateRulesStringSlices[1][0].Params, Value: x.LongStrings[i1], Err: err})
+			break rule2
+		}
+	}
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of StructWithNestedStructs according to their validate tags
+func (x *StructWithNestedStructs) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// NestedStruct1
+	errs = append(errs, validation.PrefixErrors("NestedStruct1", x.NestedStruct1.Validate())...)
+	// NestedStruct2
+	errs = append(errs, validation.PrefixErrors("NestedStruct2", x.NestedStruct2.Validate())...)
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of Tagged according to their validate tags
+func (x *Tagged) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// Len
+	if err := check.ValidString(string(x.Len), validateRulesTagged[0][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Len", Rule: validateRulesTagged[0][0].Name, Args: validateRulesTagged[0][0].Params, Value: x.Len, Err: err})
+	}
+	// LenZ
+	if err := check.ValidString(string(x.LenZ), validateRulesTagged[1][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "LenZ", Rule: validateRulesTagged[1][0].Name, Args: validateRulesTagged[1][0].Params, Value: x.LenZ, Err: err})
+	}
+	// InInt
+	if err := check.ValidInt(int64(x.InInt), strconv.IntSize, validateRulesTagged[2][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "InInt", Rule: validateRulesTagged[2][0].Name, Args: validateRulesTagged[2][0].Params, Value: x.InInt, Err: err})
+	}
+	// InNeg
+	if err := check.ValidInt(int64(x.InNeg), strconv.IntSize, validateRulesTagged[3][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "InNeg", Rule: validateRulesTagged[3][0].Name, Args: validateRulesTagged[3][0].Params, Value: x.InNeg, Err: err})
+	}
+	// InStr
+	if err := check.ValidString(string(x.InStr), validateRulesTagged[4][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "InStr", Rule: validateRulesTagged[4][0].Name, Args: validateRulesTagged[4][0].Params, Value: x.InStr, Err: err})
+	}
+	// MinInt
+	if err := check.ValidInt(int64(x.MinInt), strconv.IntSize, validateRulesTagged[5][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "MinInt", Rule: validateRulesTagged[5][0].Name, Args: validateRulesTagged[5][0].Params, Value: x.MinInt, Err: err})
+	}
+	// MinIntNeg
+	if err := check.ValidInt(int64(x.MinIntNeg), strconv.IntSize, validateRulesTagged[6][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "MinIntNeg", Rule: validateRulesTagged[6][0].Name, Args: validateRulesTagged[6][0].Params, Value: x.MinIntNeg, Err: err})
+	}
+	// MinStr
+	if err := check.ValidString(string(x.MinStr), validateRulesTagged[7][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "MinStr", Rule: validateRulesTagged[7][0].Name, Args: validateRulesTagged[7][0].Params, Value: x.MinStr, Err: err})
+	}
+	// MinStrNeg
+	if err := check.ValidString(string(x.MinStrNeg), validateRulesTagged[8][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "MinStrNeg", Rule: validateRulesTagged[8][0].Name, Args: validateRulesTagged[8][0].Params, Value: x.MinStrNeg, Err: err})
+	}
+	// MaxInt
+	if err := check.ValidInt(int64(x.MaxInt), strconv.IntSize, validateRulesTagged[9][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "MaxInt", Rule: validateRulesTagged[9][0].Name, Args: validateRulesTagged[9][0].Params, Value: x.MaxInt, Err: err})
+	}
+	// MaxIntNeg
+	if err := check.ValidInt(int64(x.MaxIntNeg), strconv.IntSize, validateRulesTagged[10][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "MaxIntNeg", Rule: validateRulesTagged[10][0].Name, Args: validateRulesTagged[10][0].Params, Value: x.MaxIntNeg, Err: err})
+	}
+	// MaxStr
+	if err := check.ValidString(string(x.MaxStr), validateRulesTagged[11][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "MaxStr", Rule: validateRulesTagged[11][0].Name, Args: validateRulesTagged[11][0].Params, Value: x.MaxStr, Err: err})
+	}
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of Transfer according to their validate tags
+func (x *Transfer) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// Amount
+	if err := check.ValidString(string(x.Amount), validateRulesTransfer[0][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Amount", Rule: validateRulesTransfer[0][0].Name, Args: validateRulesTransfer[0][0].Params, Value: x.Amount, Err: err})
+	}
+	if err := check.ValidString(string(x.Amount), validateRulesTransfer[0][1]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Amount", Rule: validateRulesTransfer[0][1].Name, Args: validateRulesTransfer[0][1].Params, Value: x.Amount, Err: err})
+	}
+	if err := check.ValidString(string(x.Amount), validateRulesTransfer[0][2]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Amount", Rule: validateRulesTransfer[0][2].Name, Args: validateRulesTransfer[0][2].Params, Value: x.Amount, Err: err})
+	}
+	// Fee
+	if err := check.ValidInt(int64(x.Fee), 64, validateRulesTransfer[1][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Fee", Rule: validateRulesTransfer[1][0].Name, Args: validateRulesTransfer[1][0].Params, Value: x.Fee, Err: err})
+	}
+	if err := check.ValidInt(int64(x.Fee), 64, validateRulesTransfer[1][1]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Fee", Rule: validateRulesTransfer[1][1].Name, Args: validateRulesTransfer[1][1].Params, Value: x.Fee, Err: err})
+	}
+	// Total
+	if err := check.ValidDecimal(x.Total, validateRulesTransfer[2][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Total", Rule: validateRulesTransfer[2][0].Name, Args: validateRulesTransfer[2][0].Params, Value: x.Total, Err: err})
+	}
+	if err := check.ValidDecimal(x.Total, validateRulesTransfer[2][1]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Total", Rule: validateRulesTransfer[2][1].Name, Args: validateRulesTransfer[2][1].Params, Value: x.Total, Err: err})
+	}
+	if err := check.ValidDecimal(x.Total, validateRulesTransfer[2][2]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Total", Rule: validateRulesTransfer[2][2].Name, Args: validateRulesTransfer[2][2].Params, Value: x.Total, Err: err})
+	}
+	// Limit
+	if p1 := x.Limit; p1 != nil {
+		if err := check.ValidDecimal(*p1, validateRulesTransfer[3][0]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "Limit", Rule: validateRulesTransfer[3][0].Name, Args: validateRulesTransfer[3][0].Params, Value: *p1, Err: err})
+		}
+	}
+	if p1 := x.Limit; p1 != nil {
+		if err := check.ValidDecimal(*p1, validateRulesTransfer[3][1]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "Limit", Rule: validateRulesTransfer[3][1].Name, Args: validateRulesTransfer[3][1].Params, Value: *p1, Err: err})
+		}
+	}
+	// Currency
+	if err := check.ValidString(string(x.Currency), validateRulesTransfer[4][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Currency", Rule: validateRulesTransfer[4][0].Name, Args: validateRulesTransfer[4][0].Params, Value: x.Currency, Err: err})
+	}
+	// Accepted
+rule1:
+	for i1 := range x.Accepted {
+		if err := check.ValidString(string(x.Accepted[i1]), validateRulesTransfer[5][0]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "Accepted[" + strconv.Itoa(i1) + "]", Rule: validateRulesTransfer[5][0].Name, Args: validateRulesTransfer[5][0].Params, Value: x.Accepted[i1], Err: err})
+			break rule1
+		}
+	}
+	// Shares
+rule2:
+	for i1 := range x.Shares {
+		if err := check.ValidDecimal(&x.Shares[i1], validateRulesTransfer[6][0]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "Shares[" + strconv.Itoa(i1) + "]", Rule: validateRulesTransfer[6][0].Name, Args: validateRulesTransfer[6][0].Params, Value: x.Shares[i1], Err: err})
+			break rule2
+		}
+	}
+rule3:
+	for i1 := range x.Shares {
+		if err := check.ValidDecimal(&x.Shares[i1], validateRulesTransfer[6][1]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "Shares[" + strconv.Itoa(i1) + "]", Rule: validateRulesTransfer[6][1].Name, Args: validateRulesTransfer[6][1].Params, Value: x.Shares[i1], Err: err})
+			break rule3
+		}
+	}
+	// Ratio
+	if err := check.ValidFloat(float64(x.Ratio), 64, validateRulesTransfer[7][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Ratio", Rule: validateRulesTransfer[7][0].Name, Args: validateRulesTransfer[7][0].Params, Value: x.Ratio, Err: err})
+	}
+	if err := check.ValidFloat(float64(x.Ratio), 64, validateRulesTransfer[7][1]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Ratio", Rule: validateRulesTransfer[7][1].Name, Args: validateRulesTransfer[7][1].Params, Value: x.Ratio, Err: err})
+	}
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of Unexported according to their validate tags
+func (x *Unexported) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	errs = append(errs, validation.ValidationError{Field: "foo", Err: validation.ErrValidateForUnexportedFields})
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of UnknownFields according to their validate tags. It
+// calls validation.Validate since field A has cross-field rule eqfield
+func (x *UnknownFields) Validate() error {
+	return validation.Validate(x)
+}
+
+// Validate checks fields of UnterminatedQuote according to their validate tags. It
+// calls validation.Validate since field Foo has rule "in:'a,b;len:3" which is not built-in
+func (x *UnterminatedQuote) Validate() error {
+	return validation.Validate(x)
+}
+
+// Validate checks fields of ValidSlices according to their validate tags
+func (x *ValidSlices) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// ShortStrings
+rule1:
+	for i1 := range x.ShortStrings {
+		if err := check.ValidString(string(x.ShortStrings[i1]), validateRulesValidSlices[0][0]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "ShortStrings[" + strconv.Itoa(i1) + "]", Rule: validateRulesValidSlices[0][0].Name, Args: validateRulesValidSlices[0][0].Params, Value: x.ShortStrings[i1], Err: err})
+			break rule1
+		}
+	}
+	// LongStrings
+rule2:
+	for i1 := range x.LongStrings {
+		if err := check.ValidString(string(x.LongStrings[i1]), validateRulesValidSlices[1][0]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "LongStrings[" + strconv.Itoa(i1) + "]", Rule: validateRulesValidSlices[1][0].Name, Args: validateRulesValidSlices[1][0].Params, Value: x.LongStrings[i1], Err: err})
+			break rule2
+		}
+	}
+	// SmallNums
+rule3:
+	for i1 := range x.SmallNums {
+		if err := check.ValidInt(int64(x.SmallNums[i1]), strconv.IntSize, validateRulesValidSlices[2][0]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "SmallNums[" + strconv.Itoa(i1) + "]", Rule: validateRulesValidSlices[2][0].Name, Args: validateRulesValidSlices[2][0].Params, Value: x.SmallNums[i1], Err: err})
+			break rule3
+		}
+	}
+	// BigNums
+rule4:
+	for i1 := range x.BigNums {
+		if err := check.ValidInt(int64(x.BigNums[i1]), strconv.IntSize, validateRulesValidSlices[3][0]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "BigNums[" + strconv.Itoa(i1) + "]", Rule: validateRulesValidSlices[3][0].Name, Args: validateRulesValidSlices[3][0].Params, Value: x.BigNums[i1], Err: err})
+			break rule4
+		}
+	}
+	// PrimeNums
+rule5:
+	for i1 := range x.PrimeNums {
+		if err := check.ValidInt(int64(x.PrimeNums[i1]), strconv.IntSize, validateRulesValidSlices[4][0]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "PrimeNums[" + strconv.Itoa(i1) + "]", Rule: validateRulesValidSlices[4][0].Name, Args: validateRulesValidSlices[4][0].Params, Value: x.PrimeNums[i1], Err: err})
+			break rule5
+		}
+	}
+	// PiDigits
+rule6:
+	for i1 := range x.PiDigits {
+		if err := check.ValidInt(int64(x.PiDigits[i1]), strconv.IntSize, validateRulesValidSlices[5][0]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "PiDigits[" + strconv.Itoa(i1) + "]", Rule: validateRulesValidSlices[5][0].Name, Args: validateRulesValidSlices[5][0].Params, Value: x.PiDigits[i1], Err: err})
+			break rule6
+		}
+	}
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of WrongFormats according to their validate tags
+func (x *WrongFormats) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// Login
+	if err := check.ValidString(string(x.Login), validateRulesWrongFormats[0][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Login", Rule: validateRulesWrongFormats[0][0].Name, Args: validateRulesWrongFormats[0][0].Params, Value: x.Login, Err: err})
+	}
+	// Email
+	if err := check.ValidString(string(x.Email), validateRulesWrongFormats[1][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Email", Rule: validateRulesWrongFormats[1][0].Name, Args: validateRulesWrongFormats[1][0].Params, Value: x.Email, Err: err})
+	}
+	// Named
+	if err := check.ValidString(string(x.Named), validateRulesWrongFormats[2][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Named", Rule: validateRulesWrongFormats[2][0].Name, Args: validateRulesWrongFormats[2][0].Params, Value: x.Named, Err: err})
+	}
+	// Site
+	if err := check.ValidString(string(x.Site), validateRulesWrongFormats[3][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Site", Rule: validateRulesWrongFormats[3][0].Name, Args: validateRulesWrongFormats[3][0].Params, Value: x.Site, Err: err})
+	}
+	// ID
+	if err := check.ValidString(string(x.ID), validateRulesWrongFormats[4][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "ID", Rule: validateRulesWrongFormats[4][0].Name, Args: validateRulesWrongFormats[4][0].Params, Value: x.ID, Err: err})
+	}
+	// Phone
+	if err := check.ValidString(string(x.Phone), validateRulesWrongFormats[5][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Phone", Rule: validateRulesWrongFormats[5][0].Name, Args: validateRulesWrongFormats[5][0].Params, Value: x.Phone, Err: err})
+	}
+	// Name
+	if err := check.ValidString(string(x.Name), validateRulesWrongFormats[6][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Name", Rule: validateRulesWrongFormats[6][0].Name, Args: validateRulesWrongFormats[6][0].Params, Value: x.Name, Err: err})
+	}
+	// Account
+	if err := check.ValidString(string(x.Account), validateRulesWrongFormats[7][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Account", Rule: validateRulesWrongFormats[7][0].Name, Args: validateRulesWrongFormats[7][0].Params, Value: x.Account, Err: err})
+	}
+	// Comment
+	if err := check.ValidString(string(x.Comment), validateRulesWrongFormats[8][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Comment", Rule: validateRulesWrongFormats[8][0].Name, Args: validateRulesWrongFormats[8][0].Params, Value: x.Comment, Err: err})
+	}
+	// INN
+	if err := check.ValidString(string(x.INN), validateRulesWrongFormats[9][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "INN", Rule: validateRulesWrongFormats[9][0].Name, Args: validateRulesWrongFormats[9][0].Params, Value: x.INN, Err: err})
+	}
+	// Hash
+	if err := check.ValidString(string(x.Hash), validateRulesWrongFormats[10][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Hash", Rule: validateRulesWrongFormats[10][0].Name, Args: validateRulesWrongFormats[10][0].Params, Value: x.Hash, Err: err})
+	}
+	// BadRe
+	errs = append(errs, validation.ValidationError{Field: "BadRe", Rule: validateRulesWrongFormats[11][0].Name, Args: validateRulesWrongFormats[11][0].Params, Err: validation.ErrInvalidValidatorSyntax})
+	// BadArgs
+	errs = append(errs, validation.ValidationError{Field: "BadArgs", Rule: validateRulesWrongFormats[12][0].Name, Args: validateRulesWrongFormats[12][0].Params, Err: validation.ErrInvalidValidatorSyntax})
+	// Number
+	if err := check.ValidInt(int64(x.Number), strconv.IntSize, validateRulesWrongFormats[13][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Number", Rule: validateRulesWrongFormats[13][0].Name, Args: validateRulesWrongFormats[13][0].Params, Value: x.Number, Err: err})
+	}
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of WrongIn according to their validate tags
+func (x *WrongIn) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// InA
+	if err := check.ValidString(string(x.InA), validateRulesWrongIn[0][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "InA", Rule: validateRulesWrongIn[0][0].Name, Args: validateRulesWrongIn[0][0].Params, Value: x.InA, Err: err})
+	}
+	// InB
+	if err := check.ValidString(string(x.InB), validateRulesWrongIn[1][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "InB", Rule: validateRulesWrongIn[1][0].Name, Args: validateRulesWrongIn[1][0].Params, Value: x.InB, Err: err})
+	}
+	// InC
+	if err := check.ValidInt(int64(x.InC), strconv.IntSize, validateRulesWrongIn[2][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "InC", Rule: validateRulesWrongIn[2][0].Name, Args: validateRulesWrongIn[2][0].Params, Value: x.InC, Err: err})
+	}
+	// InD
+	if err := check.ValidInt(int64(x.InD), strconv.IntSize, validateRulesWrongIn[3][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "InD", Rule: validateRulesWrongIn[3][0].Name, Args: validateRulesWrongIn[3][0].Params, Value: x.InD, Err: err})
+	}
+	// InEmpty
+	errs = append(errs, validation.ValidationError{Field: "InEmpty", Rule: validateRulesWrongIn[4][0].Name, Args: validateRulesWrongIn[4][0].Params, Err: validation.ErrInvalidValidatorSyntax})
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of WrongKinds according to their validate tags
+func (x *WrongKinds) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// ID
+	if err := check.ValidInt(int64(x.ID), 64, validateRulesWrongKinds[0][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "ID", Rule: validateRulesWrongKinds[0][0].Name, Args: validateRulesWrongKinds[0][0].Params, Value: x.ID, Err: err})
+	}
+	// Count
+	if err := check.ValidUint(uint64(x.Count), 32, validateRulesWrongKinds[1][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Count", Rule: validateRulesWrongKinds[1][0].Name, Args: validateRulesWrongKinds[1][0].Params, Value: x.Count, Err: err})
+	}
+	// Price
+	if err := check.ValidFloat(float64(x.Price), 64, validateRulesWrongKinds[2][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Price", Rule: validateRulesWrongKinds[2][0].Name, Args: validateRulesWrongKinds[2][0].Params, Value: x.Price, Err: err})
+	}
+	// Title
+	if err := check.ValidString(string(x.Title), validateRulesWrongKinds[3][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Title", Rule: validateRulesWrongKinds[3][0].Name, Args: validateRulesWrongKinds[3][0].Params, Value: x.Title, Err: err})
+	}
+	// Code
+	if err := check.ValidUint(uint64(x.Code), 8, validateRulesWrongKinds[4][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Code", Rule: validateRulesWrongKinds[4][0].Name, Args: validateRulesWrongKinds[4][0].Params, Value: x.Code, Err: err})
+	}
+	// Present
+	if p1 := x.Present; p1 != nil {
+		if err := check.ValidString(string(*p1), validateRulesWrongKinds[5][0]); err != nil {
+			errs = append(errs, validation.ValidationError{Field: "Present", Rule: validateRulesWrongKinds[5][0].Name, Args: validateRulesWrongKinds[5][0].Params, Value: *p1, Err: err})
+		}
+	}
+	// Small
+	if err := check.ValidInt(int64(x.Small), 8, validateRulesWrongKinds[6][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Small", Rule: validateRulesWrongKinds[6][0].Name, Args: validateRulesWrongKinds[6][0].Params, Value: x.Small, Err: err})
+	}
+	// Len
+	if err := check.ValidInt(int64(x.Len), strconv.IntSize, validateRulesWrongKinds[7][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Len", Rule: validateRulesWrongKinds[7][0].Name, Args: validateRulesWrongKinds[7][0].Params, Value: x.Len, Err: err})
+	}
+	// Flag
+	errs = append(errs, validation.ValidationError{Field: "Flag", Rule: validateRulesWrongKinds[8][0].Name, Args: validateRulesWrongKinds[8][0].Params, Value: x.Flag, Err: check.ErrInvalidFieldType})
+	// Date
+	if err := check.ValidTime(x.Date, validateRulesWrongKinds[9][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Date", Rule: validateRulesWrongKinds[9][0].Name, Args: validateRulesWrongKinds[9][0].Params, Value: x.Date, Err: err})
+	}
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of WrongLength according to their validate tags
+func (x *WrongLength) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// Lower
+	if err := check.ValidString(string(x.Lower), validateRulesWrongLength[0][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Lower", Rule: validateRulesWrongLength[0][0].Name, Args: validateRulesWrongLength[0][0].Params, Value: x.Lower, Err: err})
+	}
+	// Higher
+	if err := check.ValidString(string(x.Higher), validateRulesWrongLength[1][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Higher", Rule: validateRulesWrongLength[1][0].Name, Args: validateRulesWrongLength[1][0].Params, Value: x.Higher, Err: err})
+	}
+	// Zero
+	if err := check.ValidString(string(x.Zero), validateRulesWrongLength[2][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Zero", Rule: validateRulesWrongLength[2][0].Name, Args: validateRulesWrongLength[2][0].Params, Value: x.Zero, Err: err})
+	}
+	// BadSpec
+	errs = append(errs, validation.ValidationError{Field: "BadSpec", Rule: validateRulesWrongLength[3][0].Name, Args: validateRulesWrongLength[3][0].Params, Err: validation.ErrInvalidValidatorSyntax})
+	// Negative
+	if err := check.ValidString(string(x.Negative), validateRulesWrongLength[4][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Negative", Rule: validateRulesWrongLength[4][0].Name, Args: validateRulesWrongLength[4][0].Params, Value: x.Negative, Err: err})
+	}
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of WrongLengthModes according to their validate tags
+func (x *WrongLengthModes) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// Title
+	if err := check.ValidString(string(x.Title), validateRulesWrongLengthModes[0][1]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Title", Rule: validateRulesWrongLengthModes[0][1].Name, Args: validateRulesWrongLengthModes[0][1].Params, Value: x.Title, Err: err})
+	}
+	// Emoji
+	if err := check.ValidString(string(x.Emoji), validateRulesWrongLengthModes[1][1]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Emoji", Rule: validateRulesWrongLengthModes[1][1].Name, Args: validateRulesWrongLengthModes[1][1].Params, Value: x.Emoji, Err: err})
+	}
+	// Mode
+	errs = append(errs, validation.ValidationError{Field: "Mode", Rule: validateRulesWrongLengthModes[2][0].Name, Args: validateRulesWrongLengthModes[2][0].Params, Err: validation.ErrInvalidValidatorSyntax})
+	// Twice
+	errs = append(errs, validation.ValidationError{Field: "Twice", Rule: validateRulesWrongLengthModes[3][1].Name, Args: validateRulesWrongLengthModes[3][1].Params, Err: validation.ErrInvalidValidatorSyntax})
+	// Number
+	if err := check.ValidInt(int64(x.Number), strconv.IntSize, validateRulesWrongLengthModes[4][1]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "Number", Rule: validateRulesWrongLengthModes[4][1].Name, Args: validateRulesWrongLengthModes[4][1].Params, Value: x.Number, Err: err})
+	}
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of WrongMax according to their validate tags
+func (x *WrongMax) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// MaxA
+	if err := check.ValidString(string(x.MaxA), validateRulesWrongMax[0][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "MaxA", Rule: validateRulesWrongMax[0][0].Name, Args: validateRulesWrongMax[0][0].Params, Value: x.MaxA, Err: err})
+	}
+	// MaxB
+	if err := check.ValidString(string(x.MaxB), validateRulesWrongMax[1][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "MaxB", Rule: validateRulesWrongMax[1][0].Name, Args: validateRulesWrongMax[1][0].Params, Value: x.MaxB, Err: err})
+	}
+	// MaxC
+	if err := check.ValidInt(int64(x.MaxC), strconv.IntSize, validateRulesWrongMax[2][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "MaxC", Rule: validateRulesWrongMax[2][0].Name, Args: validateRulesWrongMax[2][0].Params, Value: x.MaxC, Err: err})
+	}
+	// MaxD
+	errs = append(errs, validation.ValidationError{Field: "MaxD", Rule: validateRulesWrongMax[3][0].Name, Args: validateRulesWrongMax[3][0].Params, Err: validation.ErrInvalidValidatorSyntax})
+	// MaxE
+	errs = append(errs, validation.ValidationError{Field: "MaxE", Rule: validateRulesWrongMax[4][0].Name, Args: validateRulesWrongMax[4][0].Params, Err: validation.ErrInvalidValidatorSyntax})
+	// MaxF
+	errs = append(errs, validation.ValidationError{Field: "MaxF", Rule: validateRulesWrongMax[5][0].Name, Args: validateRulesWrongMax[5][0].Params, Err: validation.ErrInvalidValidatorSyntax})
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks fields of WrongMin according to their validate tags
+func (x *WrongMin) Validate() error {
+	if x == nil {
+		return validation.ErrNilValue
+	}
+	var errs validation.ValidationErrors
+	// MinA
+	if err := check.ValidString(string(x.MinA), validateRulesWrongMin[0][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "MinA", Rule: validateRulesWrongMin[0][0].Name, Args: validateRulesWrongMin[0][0].Params, Value: x.MinA, Err: err})
+	}
+	// MinB
+	if err := check.ValidInt(int64(x.MinB), strconv.IntSize, validateRulesWrongMin[1][0]); err != nil {
+		errs = append(errs, validation.ValidationError{Field: "MinB", Rule: validateRulesWrongMin[1][0].Name, Args: validateRulesWrongMin[1][0].Params, Value: x.MinB, Err: err})
+	}
+	// MinC
+	errs = append(errs, validation.ValidationError{Field: "MinC", Rule: validateRulesWrongMin[2][0].Name, Args: validateRulesWrongMin[2][0].Params, Err: validation.ErrInvalidValidatorSyntax})
+	// MinD
+	errs = append(errs, validation.ValidationError{Field: "MinD", Rule: validateRulesWrongMin[3][0].Name, Args: validateRulesWrongMin[3][0].Params, Err: validation.ErrInvalidValidatorSyntax})
+	// MinE
+	errs = append(errs, validation.ValidationError{Field: "MinE", Rule: validateRulesWrongMin[4][0].Name, Args: validateRulesWrongMin[4][0].Params, Err: validation.ErrInvalidValidatorSyntax})
+	if len(errs) != 0 {
+		return errs
+	}
+	return nil
+}
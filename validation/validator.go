@@ -1,20 +1,98 @@
 package go_course_validation
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"github.com/papey08/golang-fintech/validation/check"
 	"github.com/papey08/golang-fintech/validation/parse"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
 )
 
 var ErrNotStruct = errors.New("wrong argument given, should be a struct")
+var ErrNilValue = errors.Wrap(ErrNotStruct, "nil value given")
 var ErrInvalidValidatorSyntax = errors.New("invalid validator syntax")
 var ErrValidateForUnexportedFields = errors.New("validation for unexported field is not allowed")
 
+// ValidationError describes a single failed check of a field
 type ValidationError struct {
-	Err error
+	Field   string   // full path to the field, e.g. Items[3].Name
+	Label   string   // name of the field from label tag
+	Rule    string   // name of the failed rule
+	Args    []string // args of the failed rule
+	Value   any      // value of the field which failed the rule
+	Err     error
+	Message string // message for users set by Localize
+}
+
+func (v ValidationError) Error() string {
+	if v.Message != "" {
+		return v.Message
+	}
+
+	var res strings.Builder
+	if v.Field != "" {
+		res.WriteString(v.Field + ": ")
+	}
+	if v.Rule != "" {
+		res.WriteString("rule " + v.Rule)
+		if len(v.Args) != 0 {
+			res.WriteString(":" + strings.Join(v.Args, ","))
+		}
+		if v.Value != nil {
+			res.WriteString(" failed for value " + formatValue(v.Value))
+		}
+		res.WriteString(": ")
+	}
+	res.WriteString(v.cause().Error())
+	return res.String()
+}
+
+func (v ValidationError) Unwrap() error {
+	return v.Err
+}
+
+// cause returns Err or check.ErrInvalidFieldValue if Err is not set
+func (v ValidationError) cause() error {
+	if v.Err == nil {
+		return check.ErrInvalidFieldValue
+	}
+	return v.Err
+}
+
+// jsonValidationError is a machine-readable form of ValidationError
+type jsonValidationError struct {
+	Field   string   `json:"field"`
+	Rule    string   `json:"rule,omitempty"`
+	Args    []string `json:"args,omitempty"`
+	Value   any      `json:"value,omitempty"`
+	Message string   `json:"message"`
+}
+
+// MarshalJSON makes ValidationError suitable for bodies of HTTP responses
+func (v ValidationError) MarshalJSON() ([]byte, error) {
+	res := jsonValidationError{
+		Field:   v.Field,
+		Rule:    v.Rule,
+		Args:    v.Args,
+		Value:   v.Value,
+		Message: v.Message,
+	}
+	if res.Message == "" {
+		res.Message = v.cause().Error()
+	}
+	if data, err := json.Marshal(res); err == nil {
+		return data, nil
+	}
+
+	// value can't be represented in JSON, so it is sent as a string
+	res.Value = formatValue(v.Value)
+	return json.Marshal(res)
 }
 
 type ValidationErrors []ValidationError
@@ -24,71 +102,454 @@ func (v ValidationErrors) Error() string {
 	case 0:
 		return ""
 	case 1:
-		return v[0].Err.Error()
+		return v[0].Error()
 	default:
 		var res strings.Builder
 		for _, ve := range v {
-			res.WriteString(ve.Err.Error() + "\n")
+			res.WriteString(ve.Error() + "\n")
 		}
 		return res.String()
 	}
 }
 
+// Is reports whether any of errors matches target
+func (v ValidationErrors) Is(target error) bool {
+	for _, ve := range v {
+		if errors.Is(ve.Err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks fields of struct v or of struct v points to. Returns
+// ErrNilValue if v or the pointer is nil and ErrNotStruct for values of other
+// types
 func Validate(v any) error {
-	vt := reflect.TypeOf(v)
+	return ValidateCtx(context.Background(), v)
+}
+
+// ValidateCtx is the same as Validate but passes ctx to rules registered by
+// RegisterValidationCtx and to ValidateContext methods. Returns ctx.Err() if
+// ctx is done before all rules are checked
+func ValidateCtx(ctx context.Context, v any) error {
+	rv, err := structValue(reflect.ValueOf(v))
+	if err != nil {
+		return err
+	}
+	return validateRoot(rv, planOf(rv.Type()), options{ctx: ctx})
+}
+
+// validateRoot checks struct value rv according to plan p and opts,
+// asynchronous rules are checked after all the others
+func validateRoot(rv reflect.Value, p *structPlan, opts options) error {
+	if err := opts.ctx.Err(); err != nil {
+		return err
+	}
+
+	s := &validation{ctx: opts.ctx, maxErrors: opts.maxErrors, groups: opts.groups}
+	validationErrors := s.validateStruct(rv, p, "")
+	if !s.stopped() {
+		asyncErrors, err := s.runAsync()
+		if err != nil {
+			return err
+		}
+		validationErrors = append(validationErrors, asyncErrors...)
+	}
+
+	// struct-level checks and asynchronous rules may report several errors
+	// at once
+	if s.maxErrors > 0 && len(validationErrors) > s.maxErrors {
+		validationErrors = validationErrors[:s.maxErrors]
+	}
+
+	if len(validationErrors) != 0 {
+		return validationErrors
+	} else {
+		return nil
+	}
+}
 
-	// check if v is not a struct
-	if vt.Kind() != reflect.Struct {
-		return ErrNotStruct
+// structValue unwraps pointers and interfaces around struct value v
+func structValue(v reflect.Value) (reflect.Value, error) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}, ErrNilValue
+		}
+		v = v.Elem()
 	}
 
-	fieldAmount := vt.NumField()
-	validationErrors := make(ValidationErrors, 0, fieldAmount)
+	switch v.Kind() {
+	case reflect.Struct:
+		return v, nil
+	case reflect.Invalid:
+		return v, ErrNilValue
+	default:
+		return v, ErrNotStruct
+	}
+}
 
-	for i := 0; i < fieldAmount; i++ {
-		field := vt.Field(i)
+// validation holds state of a single validation of value
+type validation struct {
+	ctx       context.Context    // passed to ContextValidatable and CtxFunc
+	visiting  map[visit]struct{} // pointers, slices and maps on the current path
+	async     []asyncCheck       // checks which are run after traversal
+	maxErrors int                // validation stops after so many errors, zero means no limit
+	found     int                // number of errors found so far
+	groups    []string           // active groups, rules of the other groups are skipped
+}
 
-		// checking if field is a struct for nested validation
-		if validationTag, ok := field.Tag.Lookup("validate"); ok {
+// visit identifies pointer, slice or map value to detect cycles like in
+// reflect.DeepEqual
+type visit struct {
+	ptr uintptr
+	typ reflect.Type
+	len int
+}
 
-			// check if filed is not exported
-			if !field.IsExported() {
-				validationErrors = append(validationErrors, ValidationError{ErrValidateForUnexportedFields})
+// enter marks value v as being checked, returns false if v is already being
+// checked, i.e. v refers to itself
+func (s *validation) enter(v reflect.Value) bool {
+	key := visit{ptr: v.Pointer(), typ: v.Type()}
+	if v.Kind() == reflect.Slice {
+		key.len = v.Len()
+	}
+	if _, ok := s.visiting[key]; ok {
+		return false
+	}
+	if s.visiting == nil {
+		s.visiting = make(map[visit]struct{})
+	}
+	s.visiting[key] = struct{}{}
+	return true
+}
+
+// leave unmarks value v after it is checked
+func (s *validation) leave(v reflect.Value) {
+	key := visit{ptr: v.Pointer(), typ: v.Type()}
+	if v.Kind() == reflect.Slice {
+		key.len = v.Len()
+	}
+	delete(s.visiting, key)
+}
+
+// validateStruct checks fields of struct value according to its plan and then
+// calls its Validate method if there is one, path is a path to the struct from
+// the validated one
+func (s *validation) validateStruct(v reflect.Value, p *structPlan, path string) ValidationErrors {
+	var validationErrors ValidationErrors
+
+	for i := range p.fields {
+		field := &p.fields[i]
+
+		// rules of groups which are not active are skipped
+		if !field.active(s.groups) {
+			if field.inactive != nil {
+				validationErrors = append(validationErrors, s.validateContent(v.Field(field.index), field.inactive, joinPath(path, field.name))...)
+				if s.stopped() {
+					return validationErrors
+				}
+			}
+			continue
+		}
+
+		// check if filed is not exported
+		if field.unexported {
+			validationErrors = append(validationErrors, ValidationError{
+				Field: joinPath(path, field.name),
+				Label: field.label,
+				Err:   ErrValidateForUnexportedFields,
+			})
+			if s.record(1) {
+				return validationErrors
+			}
+			continue
+		}
+
+		value := v.Field(field.index)
+		omitted := field.omitEmpty && check.IsEmpty(value)
+
+		// every rule of the tag is checked and reported separately
+		for j, rule := range field.rules {
+
+			// check if rule of validate tag is invalid
+			if rule.Op == parse.Wrong {
+				validationErrors = append(validationErrors, ValidationError{
+					Field: joinPath(path, field.name),
+					Label: field.label,
+					Rule:  rule.Name,
+					Args:  rule.Params,
+					Err:   ErrInvalidValidatorSyntax,
+				})
+				if s.record(1) {
+					return validationErrors
+				}
 				continue
 			}
 
-			validateOperation, args := parse.ValidationParams(validationTag)
+			// omitempty skips the other rules for empty value
+			if omitted {
+				continue
+			}
 
-			// check if validate tag is invalid
-			if validateOperation == parse.Wrong {
-				validationErrors = append(validationErrors, ValidationError{ErrInvalidValidatorSyntax})
+			// check if value of the field is inconsistent with the other field
+			if parse.IsCrossField(rule.Op) {
+				other, _ := v.FieldByIndexErr(field.refs[j])
+				if err := check.ValidCrossField(value, other, rule); err != nil {
+					validationErrors = append(validationErrors, ValidationError{
+						Field: joinPath(path, field.name),
+						Label: field.label,
+						Rule:  rule.Name,
+						Args:  rule.Params,
+						Value: displayValue(value),
+						Err:   err,
+					})
+					if s.record(1) {
+						return validationErrors
+					}
+				}
 				continue
 			}
 
-			value := reflect.ValueOf(v).FieldByName(field.Name).Interface()
+			// check if value or type of the field don't satisfy the rule
+			if ve, failed := s.checkRule(value, &field.valuePlan, rule, joinPath(path, field.name)); failed {
+				validationErrors = append(validationErrors, ve)
+				if s.record(1) {
+					return validationErrors
+				}
+			}
+		}
 
-			// check if value or type of the field don't satisfy validate tag
-			err := check.ValidField(value, validateOperation, args)
-			if err != nil {
-				validationErrors = append(validationErrors, ValidationError{err})
+		// invalid rules for keys and elements are reported even if collection
+		// is empty
+		for _, rule := range field.invalid {
+			validationErrors = append(validationErrors, ValidationError{
+				Field: joinPath(path, field.name),
+				Label: field.label,
+				Rule:  rule.Name,
+				Args:  rule.Params,
+				Err:   ErrInvalidValidatorSyntax,
+			})
+			if s.record(1) {
+				return validationErrors
 			}
+		}
+
+		if field.hasContent() && !omitted {
+			validationErrors = append(validationErrors, s.validateContent(value, &field.valuePlan, joinPath(path, field.name))...)
+			if s.stopped() {
+				return validationErrors
+			}
+		}
+	}
 
-		} else if field.IsExported() { // check for nested struct
-			value := reflect.ValueOf(v).FieldByName(field.Name).Interface()
+	// struct-level checks go after checks of its fields
+	if p.hook != noHook {
+		if err := s.callHook(v, p.hook); err != nil {
+			hookErrs := hookErrors(err, path)
+			validationErrors = append(validationErrors, hookErrs...)
+			s.record(len(hookErrs))
+		}
+	}
 
-			// check fields of exported nested struct
-			if reflect.TypeOf(value).Kind() == reflect.Struct {
-				err := Validate(value)
-				if err != nil {
-					validationErrors = append(validationErrors, ValidationError{err})
-				}
+	return validationErrors
+}
+
+// validateValue checks key or element of collection with path against its
+// plan
+func (s *validation) validateValue(v reflect.Value, vp *valuePlan, path string) ValidationErrors {
+	if vp.omitEmpty && check.IsEmpty(v) {
+		return nil
+	}
+
+	var validationErrors ValidationErrors
+	for _, rule := range vp.rules {
+		if ve, failed := s.checkRule(v, vp, rule, path); failed {
+			validationErrors = append(validationErrors, ve)
+			if s.record(1) {
+				return validationErrors
 			}
 		}
 	}
+	if vp.hasContent() {
+		validationErrors = append(validationErrors, s.validateContent(v, vp, path)...)
+	}
+	return validationErrors
+}
 
-	if len(validationErrors) != 0 {
+// validateContent checks fields of struct value and keys and elements of
+// collection with path. Nil pointers are skipped, values referring to
+// themselves are checked only once
+func (s *validation) validateContent(v reflect.Value, vp *valuePlan, path string) ValidationErrors {
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return s.validateContent(v.Elem(), vp, path)
+
+	case reflect.Pointer, reflect.Slice, reflect.Map:
+		if v.IsNil() || !s.enter(v) {
+			return nil
+		}
+		defer s.leave(v)
+		if v.Kind() == reflect.Pointer {
+			return s.validateContent(v.Elem(), vp, path)
+		}
+	}
+
+	switch {
+	case vp.nested != nil && v.Kind() == reflect.Struct:
+		return s.validateStruct(v, vp.nested, path)
+
+	case v.Kind() == reflect.Slice || v.Kind() == reflect.Array:
+		if vp.elems == nil {
+			return nil
+		}
+		var validationErrors ValidationErrors
+		for i := 0; i < v.Len() && !s.stopped(); i++ {
+			validationErrors = append(validationErrors, s.validateValue(v.Index(i), vp.elems, indexPath(path, i))...)
+		}
 		return validationErrors
-	} else {
+
+	case v.Kind() == reflect.Map:
+		var validationErrors ValidationErrors
+		for _, key := range sortedKeys(v) {
+			if s.stopped() {
+				break
+			}
+			keyPath := keyPath(path, key)
+			if vp.keys != nil {
+				validationErrors = append(validationErrors, s.validateValue(key, vp.keys, keyPath)...)
+			}
+			if vp.elems != nil && !s.stopped() {
+				validationErrors = append(validationErrors, s.validateValue(v.MapIndex(key), vp.elems, keyPath)...)
+			}
+		}
+		return validationErrors
+
+	default:
 		return nil
 	}
 }
+
+// checkRule checks value with path against rule of its plan. Asynchronous
+// rules are postponed until the end of traversal
+func (s *validation) checkRule(value reflect.Value, vp *valuePlan, rule parse.Rule, path string) (ValidationError, bool) {
+	if parse.IsCustom(rule.Op) && check.IsAsync(rule.Op) {
+		s.async = append(s.async, asyncCheck{value: value, vp: vp, rule: rule, path: path})
+		return ValidationError{}, false
+	}
+	return checkRuleCtx(s.ctx, value, vp, rule, path)
+}
+
+// checkRuleCtx checks value with path against rule of its plan
+func checkRuleCtx(ctx context.Context, value reflect.Value, vp *valuePlan, rule parse.Rule, path string) (ValidationError, bool) {
+	if vp.collection {
+		if err := check.ValidCollectionCtx(ctx, value, rule); err != nil {
+			return ValidationError{
+				Field: path,
+				Label: vp.label,
+				Rule:  rule.Name,
+				Args:  rule.Params,
+				Value: displayValue(value),
+				Err:   err,
+			}, true
+		}
+		return ValidationError{}, false
+	}
+
+	if elemPath, failed, err := validRule(ctx, value, rule); err != nil {
+		return ValidationError{
+			Field: path + elemPath,
+			Label: vp.label,
+			Rule:  rule.Name,
+			Args:  rule.Params,
+			Value: displayValue(failed),
+			Err:   err,
+		}, true
+	}
+	return ValidationError{}, false
+}
+
+// validRule checks value of field against rule and returns path of the failed
+// element relative to the field and the element itself. Nil pointers are
+// skipped. Every element of slice or array is checked, but only the first
+// failed one is reported
+func validRule(ctx context.Context, value reflect.Value, rule parse.Rule) (elemPath string, failed reflect.Value, err error) {
+	if parse.IsPresence(rule.Op) {
+		if err = check.ValidValueCtx(ctx, value, rule); err != nil {
+			return "", value, err
+		}
+		return "", reflect.Value{}, nil
+	}
+
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if value.IsNil() {
+			return "", reflect.Value{}, nil
+		}
+		return validRule(ctx, value.Elem(), rule)
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if elemPath, failed, err = validRule(ctx, value.Index(i), rule); err != nil {
+				return indexPath("", i) + elemPath, failed, err
+			}
+		}
+		return "", reflect.Value{}, nil
+	}
+
+	if err = check.ValidValueCtx(ctx, value, rule); err != nil {
+		return "", value, err
+	}
+	return "", reflect.Value{}, nil
+}
+
+// displayValue returns value of field for ValidationError, pointers are
+// dereferenced
+func displayValue(value reflect.Value) any {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+	return value.Interface()
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	if name == "" || name[0] == '[' {
+		return path + name
+	}
+	return path + "." + name
+}
+
+func indexPath(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
+
+func keyPath(path string, key reflect.Value) string {
+	return path + "[" + fmt.Sprint(key.Interface()) + "]"
+}
+
+// sortedKeys returns keys of map sorted by their representation in path, so
+// errors are reported in the same order every time
+func sortedKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+	})
+	return keys
+}
+
+// formatValue returns readable representation of value of field
+func formatValue(value any) string {
+	if v := reflect.ValueOf(value); v.Kind() == reflect.String {
+		return strconv.Quote(v.String())
+	}
+	return fmt.Sprintf("%v", value)
+}
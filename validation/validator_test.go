@@ -1,14 +1,42 @@
 package go_course_validation
 
 import (
+	"encoding/json"
 	"errors"
+	"math/big"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/papey08/golang-fintech/validation/check"
+	"github.com/papey08/golang-fintech/validation/parse"
 
 	"github.com/stretchr/testify/assert"
 )
 
+type Title string
+
+type Code uint8
+
+type Limits struct {
+	Max int
+}
+
+// CrossFields is a struct for testing cross-field validation
+type CrossFields struct {
+	DateFrom        time.Time
+	DateTo          time.Time `validate:"gtfield:DateFrom"`
+	Password        string
+	PasswordConfirm string `validate:"eqfield:Password"`
+	OldPassword     string `validate:"nefield:Password"`
+	Published       bool
+	Reason          string `validate:"required_if:Published,false"`
+	MinPrice        int
+	MaxPrice        *float64 `validate:"gtefield:MinPrice"`
+	Limits          *Limits
+	Amount          any `validate:"ltefield:Limits.Max"`
+}
+
 type NestedStruct struct {
 	N int    `validate:"max:5"`
 	S string `validate:"len:3"`
@@ -60,6 +88,54 @@ func TestValidate(t *testing.T) {
 				return errors.Is(err, ErrNotStruct)
 			},
 		},
+		{
+			name: "invalid struct: nil",
+			args: args{
+				v: nil,
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				return errors.Is(err, ErrNilValue) && errors.Is(err, ErrNotStruct)
+			},
+		},
+		{
+			name: "invalid struct: nil pointer",
+			args: args{
+				v: (*NestedStruct)(nil),
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				return errors.Is(err, ErrNilValue)
+			},
+		},
+		{
+			name: "invalid struct: pointer to string",
+			args: args{
+				v: new(string),
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				return errors.Is(err, ErrNotStruct) && !errors.Is(err, ErrNilValue)
+			},
+		},
+		{
+			name: "valid pointer to struct",
+			args: args{
+				v: &NestedStruct{N: 5, S: "abc"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong pointer to pointer to struct",
+			args: args{
+				v: func() **NestedStruct { p := &NestedStruct{N: 6, S: "abc"}; return &p }(),
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				e := &ValidationErrors{}
+				return errors.As(err, e) && len(*e) == 1 && (*e)[0].Field == "N"
+			},
+		},
 		{
 			name: "valid struct with no fields",
 			args: args{
@@ -87,7 +163,7 @@ func TestValidate(t *testing.T) {
 			wantErr: true,
 			checkErr: func(err error) bool {
 				e := &ValidationErrors{}
-				return errors.As(err, e) && e.Error() == ErrValidateForUnexportedFields.Error()
+				return errors.As(err, e) && len(*e) == 1 && errors.Is(err, ErrValidateForUnexportedFields)
 			},
 		},
 		{
@@ -100,7 +176,7 @@ func TestValidate(t *testing.T) {
 			wantErr: true,
 			checkErr: func(err error) bool {
 				e := &ValidationErrors{}
-				return errors.As(err, e) && e.Error() == ErrInvalidValidatorSyntax.Error()
+				return errors.As(err, e) && len(*e) == 1 && errors.Is(err, ErrInvalidValidatorSyntax)
 			},
 		},
 		{
@@ -342,7 +418,9 @@ func TestValidate(t *testing.T) {
 			},
 			wantErr: true,
 			checkErr: func(err error) bool {
-				assert.Len(t, err.(ValidationErrors), 1)
+				assert.Len(t, err.(ValidationErrors), 2)
+				assert.Equal(t, "NestedStruct2.N", err.(ValidationErrors)[0].Field)
+				assert.Equal(t, "NestedStruct2.S", err.(ValidationErrors)[1].Field)
 				return true
 			},
 		},
@@ -385,7 +463,335 @@ func TestValidate(t *testing.T) {
 			wantErr: true,
 			checkErr: func(err error) bool {
 				e := &ValidationErrors{}
-				return errors.As(err, e) && e.Error() == check.ErrInvalidFieldValue.Error()
+				return errors.As(err, e) && len(*e) == 1 && errors.Is(err, check.ErrInvalidFieldValue)
+			},
+		},
+		{
+			name: "valid struct with several rules per tag",
+			args: args{
+				v: struct {
+					Title  string `validate:"min:1;max:99"`
+					Text   string `validate:"min:1 max:499"`
+					Status string `validate:"len:5;in:draft,ready"`
+					Sep    string `validate:"in:'a,b','c:d';len:3"`
+					Quote  string `validate:"in:'it\\'s',other"`
+					Score  int    `validate:" min:0 ;; max:100 "`
+				}{
+					Title:  "Ad title",
+					Text:   "Ad text",
+					Status: "ready",
+					Sep:    "c:d",
+					Quote:  "it's",
+					Score:  50,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "every failed rule of tag is reported",
+			args: args{
+				v: struct {
+					Title string `validate:"min:10;max:2"`
+					Score int    `validate:"min:0 in:1,2 max:-1"`
+					Both  string `validate:"len:abc;in:x"`
+				}{
+					Title: "abcde",
+					Score: 5,
+					Both:  "y",
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				assert.Len(t, err.(ValidationErrors), 6)
+				return true
+			},
+		},
+		{
+			name: "unterminated quote in tag",
+			args: args{
+				v: struct {
+					Foo string `validate:"in:'a,b;len:3"`
+				}{},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				e := &ValidationErrors{}
+				return errors.As(err, e) && len(*e) == 1 && errors.Is(err, ErrInvalidValidatorSyntax)
+			},
+		},
+		{
+			name: "valid struct with numeric kinds, pointers and named types",
+			args: args{
+				v: struct {
+					ID       int64   `validate:"min:1"`
+					Small    int8    `validate:"in:-1,0,1"`
+					Count    uint32  `validate:"min:-5;max:10"`
+					Big      uint64  `validate:"min:18446744073709551615"`
+					Price    float64 `validate:"min:0.01;max:99.99"`
+					Rate     float32 `validate:"in:0.1,0.2"`
+					Title    Title   `validate:"min:1;max:99"`
+					Code     Code    `validate:"in:1,2,3"`
+					Optional *string `validate:"len:3"`
+					Present  *int64  `validate:"max:10"`
+					IDs      []int64 `validate:"min:1"`
+					Any      any     `validate:"max:3"`
+				}{
+					ID:       100,
+					Small:    -1,
+					Count:    10,
+					Big:      18446744073709551615,
+					Price:    99.99,
+					Rate:     0.2,
+					Title:    "Заголовок",
+					Code:     2,
+					Optional: nil,
+					Present:  new(int64),
+					IDs:      []int64{1, 2, 3},
+					Any:      "abc",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong numeric kinds, pointers and named types",
+			args: args{
+				v: struct {
+					ID      int64   `validate:"min:1"`
+					Count   uint32  `validate:"max:-1"`
+					Price   float64 `validate:"min:0.01"`
+					Title   Title   `validate:"max:3"`
+					Code    Code    `validate:"in:1,2,3"`
+					Present *string `validate:"len:3"`
+					Small   int8    `validate:"in:1000"`
+					Len     int     `validate:"len:3"`
+					Flag    bool    `validate:"in:true"`
+				}{
+					ID:      0,
+					Count:   0,
+					Price:   0.001,
+					Title:   "long title",
+					Code:    4,
+					Present: new(string),
+					Small:   1,
+					Len:     3,
+					Flag:    true,
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				e := err.(ValidationErrors)
+				assert.Len(t, e, 9)
+				for _, ve := range e[:6] {
+					assert.ErrorIs(t, ve, check.ErrInvalidFieldValue)
+				}
+				for _, ve := range e[6:] {
+					assert.ErrorIs(t, ve, check.ErrInvalidFieldType)
+				}
+				return true
+			},
+		},
+		{
+			name: "valid struct with string formats",
+			args: args{
+				v: struct {
+					Login   string  `validate:"regexp:^[a-z][a-z0-9_]{2,15}$"`
+					Quoted  string  `validate:"regexp:'^[a-z]+ [a-z]+$'"`
+					Email   string  `validate:"email"`
+					Site    string  `validate:"url"`
+					ID      string  `validate:"uuid"`
+					Phone   string  `validate:"phone"`
+					Name    string  `validate:"alpha"`
+					Account string  `validate:"alnum;len:8"`
+					Comment string  `validate:"ascii"`
+					INN     string  `validate:"numeric;len:10"`
+					Hash    string  `validate:"hex"`
+					Tags    []Title `validate:"alpha"`
+				}{
+					Login:   "papey_08",
+					Quoted:  "hello world",
+					Email:   "user@example.com",
+					Site:    "https://example.com/ads?id=1",
+					ID:      "123e4567-e89b-12d3-a456-426614174000",
+					Phone:   "+79991234567",
+					Name:    "Иван",
+					Account: "ab12CD34",
+					Comment: "plain text!",
+					INN:     "7707083893",
+					Hash:    "deadBEEF",
+					Tags:    []Title{"sale", "новинка"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong string formats",
+			args: args{
+				v: struct {
+					Login   string `validate:"regexp:^[a-z]{2,4}$"`
+					Email   string `validate:"email"`
+					Named   string `validate:"email"`
+					Site    string `validate:"url"`
+					ID      string `validate:"uuid"`
+					Phone   string `validate:"phone"`
+					Name    string `validate:"alpha"`
+					Account string `validate:"alnum"`
+					Comment string `validate:"ascii"`
+					INN     string `validate:"numeric"`
+					Hash    string `validate:"hex"`
+					BadRe   string `validate:"regexp:a(b"`
+					BadArgs string `validate:"email:strict"`
+					Number  int    `validate:"numeric"`
+				}{
+					Login:   "abcdef",
+					Email:   "user@",
+					Named:   "User <user@example.com>",
+					Site:    "example.com",
+					ID:      "123e4567e89b12d3a456426614174000",
+					Phone:   "89991234567",
+					Name:    "Ivan2",
+					Account: "ab-12",
+					Comment: "текст",
+					INN:     "",
+					Hash:    "0xff",
+					BadRe:   "ab",
+					BadArgs: "user@example.com",
+					Number:  12,
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				e := err.(ValidationErrors)
+				assert.Len(t, e, 14)
+				for _, ve := range e[:11] {
+					assert.ErrorIs(t, ve, check.ErrInvalidFieldValue)
+				}
+				assert.ErrorIs(t, e[11], ErrInvalidValidatorSyntax)
+				assert.ErrorIs(t, e[12], ErrInvalidValidatorSyntax)
+				assert.ErrorIs(t, e[13], check.ErrInvalidFieldType)
+				return true
+			},
+		},
+		{
+			name: "length of string is measured in runes by default",
+			args: args{
+				v: struct {
+					Title    string `validate:"lenInterval:1,99"`
+					Code     string `validate:"len:3"`
+					Bytes    string `validate:"max:6;lenmode:bytes"`
+					Emoji    string `validate:"lenmode:graphemes;len:2"`
+					EmojiRun string `validate:"len:7"`
+				}{
+					Title:    strings.Repeat("Объявление", 6),
+					Code:     "руб",
+					Bytes:    "руб",
+					Emoji:    "👨‍👩‍👧👍🏽",
+					EmojiRun: "👨‍👩‍👧👍🏽",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong length in bytes and graphemes",
+			args: args{
+				v: struct {
+					Title  string `validate:"lenmode:bytes;lenInterval:1,99"`
+					Emoji  string `validate:"lenmode:graphemes;max:1"`
+					Mode   string `validate:"lenmode:chars"`
+					Twice  string `validate:"lenmode:bytes;lenmode:runes"`
+					Number int    `validate:"lenmode:bytes;max:5"`
+				}{
+					Title:  strings.Repeat("Объявление", 6),
+					Emoji:  "👨‍👩‍👧👍🏽",
+					Mode:   "abc",
+					Twice:  "abc",
+					Number: 1,
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				e := err.(ValidationErrors)
+				assert.Len(t, e, 4)
+				assert.ErrorIs(t, e[0], check.ErrInvalidFieldValue)
+				assert.ErrorIs(t, e[1], check.ErrInvalidFieldValue)
+				assert.ErrorIs(t, e[2], ErrInvalidValidatorSyntax)
+				assert.ErrorIs(t, e[3], ErrInvalidValidatorSyntax)
+				return true
+			},
+		},
+		{
+			name: "valid cross-field rules",
+			args: args{
+				v: CrossFields{
+					DateFrom:        time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+					DateTo:          time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+					Password:        "secret",
+					PasswordConfirm: "secret",
+					OldPassword:     "qwerty",
+					Published:       false,
+					Reason:          "draft",
+					MinPrice:        10,
+					MaxPrice:        func() *float64 { f := 20.5; return &f }(),
+					Limits:          &Limits{Max: 100},
+					Amount:          uint8(100),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "wrong cross-field rules",
+			args: args{
+				v: CrossFields{
+					DateFrom:        time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+					DateTo:          time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+					Password:        "secret",
+					PasswordConfirm: "Secret",
+					OldPassword:     "secret",
+					Published:       false,
+					MinPrice:        10,
+					Limits:          &Limits{Max: 99},
+					Amount:          uint8(100),
+				},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				e := err.(ValidationErrors)
+				assert.Len(t, e, 5)
+				assert.Equal(t, "DateTo: rule gtfield:DateFrom failed for value 2023-01-01 00:00:00 +0000 UTC: value of field is not validate", e[0].Error())
+				assert.Equal(t, ValidationError{
+					Field: "PasswordConfirm",
+					Rule:  "eqfield",
+					Args:  []string{"Password"},
+					Value: "Secret",
+					Err:   check.ErrInvalidFieldValue,
+				}, e[1])
+				assert.Equal(t, "OldPassword", e[2].Field)
+				assert.Equal(t, "Reason", e[3].Field)
+				assert.Equal(t, "Amount", e[4].Field)
+				return true
+			},
+		},
+		{
+			name: "cross-field rules with unknown fields",
+			args: args{
+				v: struct {
+					A int    `validate:"eqfield:B"`
+					B string `validate:"ltfield:a"`
+					C int    `validate:"gtfield:A.B"`
+					D string `validate:"required_if:A"`
+					E string `validate:"eqfield:A"`
+					a int
+				}{},
+			},
+			wantErr: true,
+			checkErr: func(err error) bool {
+				e := err.(ValidationErrors)
+				assert.Len(t, e, 5)
+				assert.ErrorIs(t, e[0], check.ErrInvalidFieldType)
+				for _, ve := range e[1:4] {
+					assert.ErrorIs(t, ve, ErrInvalidValidatorSyntax)
+				}
+				assert.ErrorIs(t, e[4], check.ErrInvalidFieldType)
+				return true
 			},
 		},
 	}
@@ -402,3 +808,446 @@ func TestValidate(t *testing.T) {
 	}
 
 }
+
+type Item struct {
+	Name  string `validate:"min:1"`
+	Price int    `validate:"min:0"`
+}
+
+type Order struct {
+	ID    int      `validate:"min:1"`
+	Tags  []string `validate:"max:3"`
+	Items []Item
+}
+
+func TestValidationErrors(t *testing.T) {
+	order := Order{
+		ID:   0,
+		Tags: []string{"new", "sale", "discount"},
+		Items: []Item{
+			{Name: "pen", Price: 10},
+			{Name: "", Price: -5},
+		},
+	}
+
+	err := Validate(order)
+	assert.Error(t, err)
+
+	var ve ValidationErrors
+	assert.True(t, errors.As(err, &ve))
+	assert.True(t, errors.Is(err, check.ErrInvalidFieldValue))
+	assert.Equal(t, []ValidationError{
+		{Field: "ID", Rule: "min", Args: []string{"1"}, Value: 0, Err: check.ErrInvalidFieldValue},
+		{Field: "Tags[1]", Rule: "max", Args: []string{"3"}, Value: "sale", Err: check.ErrInvalidFieldValue},
+		{Field: "Items[1].Name", Rule: "min", Args: []string{"1"}, Value: "", Err: check.ErrInvalidFieldValue},
+		{Field: "Items[1].Price", Rule: "min", Args: []string{"0"}, Value: -5, Err: check.ErrInvalidFieldValue},
+	}, []ValidationError(ve))
+
+	assert.Equal(t, `Tags[1]: rule max:3 failed for value "sale": value of field is not validate`, ve[1].Error())
+	assert.Equal(t, "ID: rule min:1 failed for value 0: value of field is not validate\n"+
+		"Tags[1]: rule max:3 failed for value \"sale\": value of field is not validate\n"+
+		"Items[1].Name: rule min:1 failed for value \"\": value of field is not validate\n"+
+		"Items[1].Price: rule min:0 failed for value -5: value of field is not validate\n", err.Error())
+
+	data, jsonErr := json.Marshal(ve[:2])
+	assert.NoError(t, jsonErr)
+	assert.JSONEq(t, `[
+		{"field": "ID", "rule": "min", "args": ["1"], "value": 0, "message": "value of field is not validate"},
+		{"field": "Tags[1]", "rule": "max", "args": ["3"], "value": "sale", "message": "value of field is not validate"}
+	]`, string(data))
+}
+
+func TestRegexpIsCompiledOnce(t *testing.T) {
+	rules1 := parse.ValidationRules("regexp:^[0-9]{1,3}$")
+	rules2 := parse.ValidationRules("min:1;regexp:^[0-9]{1,3}$")
+	assert.Equal(t, []string{"^[0-9]{1,3}$"}, rules1[0].Params)
+	assert.Same(t, rules1[0].Args, rules2[1].Args)
+}
+
+func TestQuotedRegexpKeepsEscapes(t *testing.T) {
+	assert.Equal(t, []string{`^\d+$`}, parse.ValidationRules(`regexp:'^\d+$'`)[0].Params)
+	assert.Equal(t, []string{`it's \s`}, parse.ValidationRules(`regexp:'it\'s \s'`)[0].Params)
+
+	type Form struct {
+		Digits string `validate:"regexp:'^\\d+$'"`
+		Words  string `validate:"regexp:'^\\S+\\s\\S+$'"`
+	}
+	assert.NoError(t, Validate(Form{Digits: "123", Words: "hello world"}))
+
+	var ve ValidationErrors
+	assert.True(t, errors.As(Validate(Form{Digits: "12a", Words: "hello"}), &ve))
+	assert.Len(t, ve, 2)
+}
+
+type Warehouse struct {
+	Codes    []int          `validate:"min:1;dive;min:100"`
+	Stock    map[string]int `validate:"max:2;dive;keys;len:3;endkeys;min:0"`
+	Items    []*Item        `validate:"min:1;dive"`
+	ByID     map[int]Item   `validate:"dive"`
+	Main     *Item
+	Slots    [2]*Item          `validate:"len:2;dive"`
+	Managers map[string][]Item `validate:"dive;dive"`
+	Aliases  map[string]string `validate:"dive;keys;max:3;endkeys"`
+	Parent   *Warehouse
+}
+
+func TestDive(t *testing.T) {
+	valid := Warehouse{
+		Codes: []int{100, 200},
+		Stock: map[string]int{"pen": 1, "cup": 0},
+		Items: []*Item{{Name: "pen"}, nil},
+		ByID:  map[int]Item{1: {Name: "pen"}},
+		Main:  &Item{Name: "cup"},
+	}
+	assert.NoError(t, Validate(valid))
+
+	err := Validate(Warehouse{
+		Codes:    []int{100, 1, 2},
+		Stock:    map[string]int{"pen": -1, "mugs": 1, "cup": 0},
+		ByID:     map[int]Item{2: {Name: "cup", Price: -1}, 1: {Name: ""}},
+		Main:     &Item{Price: 1},
+		Slots:    [2]*Item{{Name: "a"}, {}},
+		Managers: map[string][]Item{"bob": {{Name: "b"}, {Name: "", Price: 2}}},
+		Aliases:  map[string]string{"abc": "", "abcd": ""},
+		Parent:   &Warehouse{Codes: []int{5}, Items: []*Item{{Name: "pen"}}},
+	})
+	var ve ValidationErrors
+	assert.True(t, errors.As(err, &ve))
+	assert.Equal(t, []ValidationError{
+		{Field: "Codes[1]", Rule: "min", Args: []string{"100"}, Value: 1, Err: check.ErrInvalidFieldValue},
+		{Field: "Codes[2]", Rule: "min", Args: []string{"100"}, Value: 2, Err: check.ErrInvalidFieldValue},
+		{Field: "Stock", Rule: "max", Args: []string{"2"}, Value: map[string]int{"pen": -1, "mugs": 1, "cup": 0}, Err: check.ErrInvalidFieldValue},
+		{Field: "Stock[mugs]", Rule: "len", Args: []string{"3"}, Value: "mugs", Err: check.ErrInvalidFieldValue},
+		{Field: "Stock[pen]", Rule: "min", Args: []string{"0"}, Value: -1, Err: check.ErrInvalidFieldValue},
+		{Field: "Items", Rule: "min", Args: []string{"1"}, Value: []*Item(nil), Err: check.ErrInvalidFieldValue},
+		{Field: "ByID[1].Name", Rule: "min", Args: []string{"1"}, Value: "", Err: check.ErrInvalidFieldValue},
+		{Field: "ByID[2].Price", Rule: "min", Args: []string{"0"}, Value: -1, Err: check.ErrInvalidFieldValue},
+		{Field: "Main.Name", Rule: "min", Args: []string{"1"}, Value: "", Err: check.ErrInvalidFieldValue},
+		{Field: "Slots[1].Name", Rule: "min", Args: []string{"1"}, Value: "", Err: check.ErrInvalidFieldValue},
+		{Field: "Managers[bob][1].Name", Rule: "min", Args: []string{"1"}, Value: "", Err: check.ErrInvalidFieldValue},
+		{Field: "Aliases[abcd]", Rule: "max", Args: []string{"3"}, Value: "abcd", Err: check.ErrInvalidFieldValue},
+		{Field: "Parent.Codes[0]", Rule: "min", Args: []string{"100"}, Value: 5, Err: check.ErrInvalidFieldValue},
+	}, []ValidationError(ve))
+}
+
+func TestDiveInvalidSyntax(t *testing.T) {
+	err := Validate(struct {
+		A int            `validate:"dive;min:1"`
+		B []int          `validate:"dive;keys;min:1"`
+		C []int          `validate:"dive;keys;min:1;endkeys"`
+		D map[string]int `validate:"endkeys;dive;eqfield:A"`
+		E map[string]int `validate:"dive;keys;max;endkeys"`
+	}{})
+	var ve ValidationErrors
+	assert.True(t, errors.As(err, &ve))
+	assert.Len(t, ve, 6)
+	for _, e := range ve {
+		assert.ErrorIs(t, e, ErrInvalidValidatorSyntax)
+	}
+	assert.Equal(t, []string{"A", "B", "C", "D", "D", "E"}, []string{ve[0].Field, ve[1].Field, ve[2].Field, ve[3].Field, ve[4].Field, ve[5].Field})
+	assert.Equal(t, "dive", ve[0].Rule)
+	assert.Equal(t, "keys", ve[1].Rule)
+	assert.Equal(t, "keys", ve[2].Rule)
+	assert.Equal(t, "endkeys", ve[3].Rule)
+	assert.Equal(t, "eqfield", ve[4].Rule)
+	assert.Equal(t, "max", ve[5].Rule)
+}
+
+// Profile is a struct for testing rules for dates and durations
+type Profile struct {
+	Birthday   time.Time     `validate:"before:now-157680h;after:1900-01-01"`
+	Registered time.Time     `validate:"after:'2020-01-01 00:00:00';tz:Europe/Moscow"`
+	LastSeen   *time.Time    `validate:"within:720h"`
+	Meeting    time.Time     `validate:"weekday"`
+	Party      time.Time     `validate:"weekday:sat,sun tz:Europe/Moscow"`
+	Timeout    time.Duration `validate:"min:1s;max:1m30s"`
+	Retry      time.Duration `validate:"in:1s,2s,5000000000"`
+}
+
+func TestTimeRules(t *testing.T) {
+	now := time.Now()
+	valid := Profile{
+		Birthday:   time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC),
+		Registered: time.Date(2019, 12, 31, 22, 0, 0, 0, time.UTC), // 2020-01-01 01:00 in Moscow
+		LastSeen:   &now,
+		Meeting:    time.Date(2024, 1, 5, 12, 0, 0, 0, time.UTC),
+		Party:      time.Date(2024, 1, 5, 22, 0, 0, 0, time.UTC), // Saturday in Moscow
+		Timeout:    30 * time.Second,
+		Retry:      5 * time.Second,
+	}
+	assert.NoError(t, Validate(valid))
+
+	lastSeen := now.Add(-1000 * time.Hour)
+	err := Validate(Profile{
+		Birthday:   now.Add(-24 * time.Hour),
+		Registered: time.Date(2019, 12, 31, 20, 0, 0, 0, time.UTC),
+		LastSeen:   &lastSeen,
+		Meeting:    time.Date(2024, 1, 6, 12, 0, 0, 0, time.UTC),
+		Party:      time.Date(2024, 1, 5, 20, 0, 0, 0, time.UTC),
+		Timeout:    2 * time.Minute,
+		Retry:      3 * time.Second,
+	})
+	var ve ValidationErrors
+	assert.True(t, errors.As(err, &ve))
+	assert.Equal(t, []string{"Birthday", "Registered", "LastSeen", "Meeting", "Party", "Timeout", "Retry"},
+		[]string{ve[0].Field, ve[1].Field, ve[2].Field, ve[3].Field, ve[4].Field, ve[5].Field, ve[6].Field})
+	for _, e := range ve {
+		assert.ErrorIs(t, e, check.ErrInvalidFieldValue)
+	}
+	assert.Equal(t, "max", ve[5].Rule)
+	assert.Equal(t, "Meeting must be a weekday", ve[3].Localize("en").Message)
+	assert.Equal(t, "Party must fall on sat, sun", ve[4].Localize("en").Message)
+
+	// durations and time rules can't be applied to the other types
+	err = Validate(struct {
+		Attempts int    `validate:"max:1h"`
+		Name     string `validate:"before:now"`
+	}{})
+	assert.True(t, errors.As(err, &ve))
+	assert.Len(t, ve, 2)
+	assert.ErrorIs(t, ve, check.ErrInvalidFieldType)
+}
+
+func TestTimeRulesInvalidSyntax(t *testing.T) {
+	err := Validate(struct {
+		A time.Time     `validate:"before:yesterday"`
+		B time.Time     `validate:"after"`
+		C time.Time     `validate:"within:-1h"`
+		D time.Time     `validate:"weekday:holiday"`
+		E time.Time     `validate:"tz:Mars/Olympus"`
+		F time.Time     `validate:"tz:UTC;tz:Local"`
+		G time.Duration `validate:"min:1hour"`
+	}{})
+	var ve ValidationErrors
+	assert.True(t, errors.As(err, &ve))
+	assert.Len(t, ve, 7)
+	for _, e := range ve {
+		assert.ErrorIs(t, e, ErrInvalidValidatorSyntax)
+	}
+}
+
+// Cents is a decimal with value receivers for testing money rules, its value
+// is Units * 10^Exp
+type Cents struct {
+	Units int64
+	Exp   int32
+}
+
+func (c Cents) Coefficient() *big.Int { return big.NewInt(c.Units) }
+func (c Cents) Exponent() int32       { return c.Exp }
+
+// Rate is a decimal with pointer receivers
+type Rate struct {
+	Coef *big.Int
+	Exp  int32
+}
+
+func (r *Rate) Coefficient() *big.Int { return r.Coef }
+func (r *Rate) Exponent() int32       { return r.Exp }
+
+// Transfer is a struct for testing money rules
+type Transfer struct {
+	Amount   string   `validate:"positive;scale:2;precision:18"`
+	Fee      int64    `validate:"decmin:0;decmax:10000"`
+	Total    Cents    `validate:"positive;scale:2;decmax:1000000.00"`
+	Limit    *Cents   `validate:"min:0.01;max:99.99"`
+	Currency string   `validate:"currency:ISO4217"`
+	Accepted []string `validate:"currency:RUB,USD"`
+	Share    Rate     `validate:"decmin:0.1;decmax:0.3"`
+}
+
+func TestMoneyRules(t *testing.T) {
+	valid := Transfer{
+		Amount:   "1234567890123456.70",
+		Fee:      10000,
+		Total:    Cents{Units: 100000000, Exp: -2},
+		Limit:    &Cents{Units: 9999, Exp: -2},
+		Currency: "RUB",
+		Accepted: []string{"USD", "RUB"},
+		Share:    Rate{Coef: big.NewInt(3), Exp: -1}, // 0.1 + 0.2 in float64 is above 0.3
+	}
+	assert.NoError(t, Validate(valid))
+	assert.NoError(t, Validate(&valid))
+
+	err := Validate(Transfer{
+		Amount:   "10.005",
+		Fee:      -1,
+		Total:    Cents{},
+		Limit:    &Cents{Units: 1, Exp: 2},
+		Currency: "RUR",
+		Accepted: []string{"USD", "EUR"},
+		Share:    Rate{Coef: big.NewInt(30000000000000001), Exp: -17},
+	})
+	var ve ValidationErrors
+	assert.True(t, errors.As(err, &ve))
+	assert.Equal(t, []string{"Amount", "Fee", "Total", "Limit", "Currency", "Accepted[1]", "Share"},
+		[]string{ve[0].Field, ve[1].Field, ve[2].Field, ve[3].Field, ve[4].Field, ve[5].Field, ve[6].Field})
+	for _, e := range ve {
+		assert.ErrorIs(t, e, check.ErrInvalidFieldValue)
+	}
+	assert.Equal(t, []string{"scale", "decmin", "positive", "max", "currency", "currency", "decmax"},
+		[]string{ve[0].Rule, ve[1].Rule, ve[2].Rule, ve[3].Rule, ve[4].Rule, ve[5].Rule, ve[6].Rule})
+	assert.Equal(t, "Amount must have at most 2 fractional digits", ve[0].Localize("en").Message)
+	assert.Equal(t, "Currency must be a valid ISO 4217 currency code", ve[4].Localize("en").Message)
+	assert.Equal(t, "Accepted[1] must be one of RUB, USD", ve[5].Localize("en").Message)
+
+	// trailing zeros are not significant, strings must be plain decimals
+	err = Validate(struct {
+		A string `validate:"scale:1;precision:2"`
+		B string `validate:"precision:3"`
+		C string `validate:"positive"`
+		D string `validate:"decmin:0"`
+		E uint8  `validate:"precision:2"`
+	}{A: "1.5000", B: "0.0001", C: "1e3", D: ".5", E: 255})
+	assert.True(t, errors.As(err, &ve))
+	assert.Equal(t, []string{"B", "C", "D", "E"}, []string{ve[0].Field, ve[1].Field, ve[2].Field, ve[3].Field})
+
+	// huge exponents are compared without building huge numbers
+	assert.NoError(t, Validate(struct {
+		A Cents `validate:"decmin:1000"`
+		B Cents `validate:"decmax:0.001"`
+	}{A: Cents{Units: 1, Exp: 1 << 30}, B: Cents{Units: 1, Exp: -(1 << 30)}}))
+
+	// positive works for any number, the other money rules need exact values
+	assert.NoError(t, Validate(struct {
+		A float64 `validate:"positive"`
+		B uint    `validate:"positive"`
+	}{A: 0.5, B: 1}))
+	err = Validate(struct {
+		A float64 `validate:"scale:2"`
+		B bool    `validate:"positive"`
+		C int     `validate:"currency"`
+	}{})
+	assert.True(t, errors.As(err, &ve))
+	assert.Len(t, ve, 3)
+	assert.ErrorIs(t, ve, check.ErrInvalidFieldType)
+}
+
+func TestMoneyRulesInvalidSyntax(t *testing.T) {
+	err := Validate(struct {
+		A string `validate:"scale:-1"`
+		B string `validate:"precision:0"`
+		C string `validate:"currency:RUBX"`
+		D string `validate:"decmin:1e3"`
+		E string `validate:"positive:1"`
+		F string `validate:"decmax:.5"`
+		G string `validate:"scale"`
+	}{})
+	var ve ValidationErrors
+	assert.True(t, errors.As(err, &ve))
+	assert.Len(t, ve, 7)
+	for _, e := range ve {
+		assert.ErrorIs(t, e, ErrInvalidValidatorSyntax)
+	}
+}
+
+type Reference struct {
+	Phone *string `validate:"required"`
+	Email string  `validate:"omitempty;email"`
+	Note  *string `validate:"nonzero"`
+}
+
+type Signup struct {
+	Name       string            `validate:"required;max:16"`
+	Age        *int              `validate:"required;min:18"`
+	Tags       []string          `validate:"nonzero;dive;omitempty;min:2"`
+	Meta       map[string]string `validate:"required"`
+	Born       time.Time         `validate:"nonzero"`
+	Referrer   *Reference        `validate:"omitempty"`
+	References []Reference       `validate:"omitempty"`
+	Agreed     bool              `validate:"nonzero"`
+	Score      float64           `validate:"nonzero"`
+}
+
+func TestMoneyRulesValidField(t *testing.T) {
+	// rules built without tag have args only
+	amount := Cents{Units: 250, Exp: -2}
+	assert.NoError(t, check.ValidField(amount, parse.Min, 2))
+	assert.NoError(t, check.ValidField(amount, parse.Max, 2.5))
+	assert.ErrorIs(t, check.ValidField(amount, parse.Max, 2), check.ErrInvalidFieldValue)
+	assert.ErrorIs(t, check.ValidField(amount, parse.Min, 2.51), check.ErrInvalidFieldValue)
+	assert.ErrorIs(t, check.ValidField(amount, parse.Max, "3"), check.ErrInvalidFieldType)
+}
+
+func TestCurrencyCodes(t *testing.T) {
+	for _, code := range []string{"RUB", "USD", "XCG", "ZWG", "SLE", "XAU", "XDR"} {
+		assert.True(t, parse.IsCurrency(code), code)
+	}
+	// withdrawn codes, bond market units, testing and no currency
+	for _, code := range []string{"ANG", "CUC", "SLL", "ZWL", "XBA", "XBB", "XBC", "XBD", "XTS", "XXX", "rub"} {
+		assert.False(t, parse.IsCurrency(code), code)
+	}
+}
+
+func TestPresenceRules(t *testing.T) {
+	phone, age := "", 0
+	valid := Signup{
+		Name:       "bob",
+		Age:        &age, // required is satisfied by pointer to zero value
+		Tags:       []string{"go", ""},
+		Meta:       map[string]string{"a": "b"},
+		Born:       time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+		References: []Reference{{Phone: &phone, Note: new(string)}},
+		Agreed:     true,
+		Score:      0.5,
+	}
+	err := Validate(valid)
+	var ve ValidationErrors
+	assert.True(t, errors.As(err, &ve))
+	// min:18 is checked for the pointed value, nonzero dereferences pointer
+	assert.Equal(t, []string{"Age", "References[0].Note"}, fields(ve))
+	assert.Equal(t, []string{"min", "nonzero"}, []string{ve[0].Rule, ve[1].Rule})
+
+	age = 18
+	note := "call after 6pm"
+	valid.References[0].Note = &note
+	assert.NoError(t, Validate(&valid))
+
+	err = Validate(Signup{Tags: []string{}, Meta: map[string]string{}, Score: -0.0})
+	assert.True(t, errors.As(err, &ve))
+	assert.Equal(t, []string{"Name", "Age", "Tags", "Meta", "Born", "Agreed", "Score"}, fields(ve))
+	for _, e := range ve {
+		assert.ErrorIs(t, e, check.ErrInvalidFieldValue)
+	}
+	assert.Equal(t, "Name is required", ve[0].Localize("en").Message)
+	assert.Equal(t, "Tags must not be empty", ve[2].Localize("en").Message)
+	assert.Equal(t, "Name: поле обязательно", ve[0].Localize("ru").Message)
+
+	// omitempty skips the other rules and content of empty value only
+	err = Validate(Reference{Phone: &phone, Email: "", Note: &phone})
+	assert.True(t, errors.As(err, &ve))
+	assert.Equal(t, []string{"Note"}, fields(ve))
+	err = Validate(struct {
+		Email      string      `validate:"omitempty;email"`
+		Referrer   *Reference  `validate:"omitempty"`
+		References []Reference `validate:"omitempty"`
+	}{Email: "bob", Referrer: &Reference{}, References: []Reference{{}}})
+	assert.True(t, errors.As(err, &ve))
+	assert.Equal(t, []string{"Email", "Referrer.Phone", "Referrer.Note", "References[0].Phone", "References[0].Note"}, fields(ve))
+
+	// the other rules don't fail for empty values, so min:-1 doesn't require
+	// a string, while required does
+	assert.NoError(t, Validate(struct {
+		A string `validate:"min:-1"`
+		B []int  `validate:"max:1"`
+		C *int   `validate:"min:1"`
+	}{}))
+}
+
+func TestPresenceRulesInvalidSyntax(t *testing.T) {
+	one := 1
+	err := Validate(struct {
+		A string   `validate:"required:1"`
+		B string   `validate:"omitempty;required"`
+		C *int     `validate:"nonzero;omitempty;min:1"`
+		D string   `validate:"omitempty;required_if:A,a"`
+		E []string `validate:"omitempty;dive;required"`
+	}{B: "b", C: &one, D: "d"})
+	var ve ValidationErrors
+	assert.True(t, errors.As(err, &ve))
+	assert.Equal(t, []string{"A", "B", "C", "D"}, fields(ve))
+	for _, e := range ve {
+		assert.ErrorIs(t, e, ErrInvalidValidatorSyntax)
+	}
+	assert.Equal(t, []string{"required", "omitempty", "omitempty", "omitempty"}, []string{ve[0].Rule, ve[1].Rule, ve[2].Rule, ve[3].Rule})
+}